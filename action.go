@@ -0,0 +1,87 @@
+package telegrambot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Nilai action untuk SendChatAction
+const (
+	ChatActionTyping          = "typing"
+	ChatActionUploadPhoto     = "upload_photo"
+	ChatActionRecordVideo     = "record_video"
+	ChatActionUploadVideo     = "upload_video"
+	ChatActionRecordVoice     = "record_voice"
+	ChatActionUploadVoice     = "upload_voice"
+	ChatActionUploadDocument  = "upload_document"
+	ChatActionChooseSticker   = "choose_sticker"
+	ChatActionFindLocation    = "find_location"
+	ChatActionRecordVideoNote = "record_video_note"
+	ChatActionUploadVideoNote = "upload_video_note"
+)
+
+// chatActionInterval lebih pendek dari ~5 detik ketika Telegram menghapus status chat action
+const chatActionInterval = 4 * time.Second
+
+// SendChatAction menampilkan status seperti "typing..." di chat selama sekitar 5 detik
+func (b *Bot) SendChatAction(chatID int64, action string) error {
+	return b.SendChatActionInThread(chatID, 0, action)
+}
+
+// SendChatActionInThread sama dengan SendChatAction tetapi status ditampilkan di topik forum
+// threadID; 0 berarti tanpa topik
+func (b *Bot) SendChatActionInThread(chatID int64, threadID int, action string) error {
+	return b.sendChatAction(context.Background(), chatID, threadID, action)
+}
+
+// sendChatAction memanggil sendChatAction dengan ctx
+func (b *Bot) sendChatAction(ctx context.Context, chatID int64, threadID int, action string) error {
+	data := url.Values{}
+	data.Set("chat_id", strconv.FormatInt(chatID, 10))
+	setOptionalInt(data, "message_thread_id", threadID)
+	data.Set("action", action)
+	return b.doRequest(ctx, "sendChatAction", data, nil)
+}
+
+// KeepChatAction mengirim ulang action setiap 4 detik sampai done ditutup; request yang sedang
+// berjalan ikut dibatalkan. Fungsi ini memblokir; jalankan di goroutine terpisah.
+func (b *Bot) KeepChatAction(chatID int64, action string, done <-chan struct{}) {
+	stop := b.WithChatAction(context.Background(), chatID, action)
+	<-done
+	stop()
+}
+
+// WithChatAction mengirim action segera lalu setiap 4 detik sampai ctx dibatalkan atau fungsi
+// stop yang dikembalikan dipanggil. stop membatalkan request yang sedang berjalan dan menunggu
+// goroutine pengirim selesai, sehingga tidak ada action yang terkirim setelah stop kembali.
+// Error pengiriman hanya dicatat di level debug.
+//
+//	stop := bot.WithChatAction(ctx, chatID, telegrambot.ChatActionUploadPhoto)
+//	img, err := render(ctx)
+//	stop()
+func (b *Bot) WithChatAction(ctx context.Context, chatID int64, action string) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+		for {
+			if err := b.sendChatAction(ctx, chatID, 0, action); err != nil && ctx.Err() == nil {
+				b.debugf("telegram: sendChatAction: %v", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
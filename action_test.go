@@ -0,0 +1,81 @@
+package telegrambot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithChatAction(t *testing.T) {
+	var sent int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("action") != ChatActionTyping {
+			t.Errorf("action = %q, want typing", r.PostForm.Get("action"))
+		}
+		atomic.AddInt32(&sent, 1)
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+
+	stop := bot.WithChatAction(context.Background(), 1, ChatActionTyping)
+	deadline := time.Now().Add(2 * time.Second)
+	for atomic.LoadInt32(&sent) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	stop()
+	after := atomic.LoadInt32(&sent)
+	if after != 1 {
+		t.Fatalf("sent %d actions, want 1 before the first tick", after)
+	}
+	stop() // stop kedua tidak boleh memblokir atau panic
+
+	// pembatalan ctx juga menghentikan goroutine; stop kembali segera
+	ctx, cancel := context.WithCancel(context.Background())
+	stop = bot.WithChatAction(ctx, 1, ChatActionTyping)
+	cancel()
+	finished := make(chan struct{})
+	go func() {
+		stop()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+	case <-time.After(2 * time.Second):
+		t.Fatal("stop did not return after ctx was cancelled")
+	}
+}
+
+func TestKeepChatActionCancelsInFlight(t *testing.T) {
+	started := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		close(started)
+		// request ditahan sampai client membatalkannya
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+
+	done := make(chan struct{})
+	returned := make(chan struct{})
+	go func() {
+		bot.KeepChatAction(1, ChatActionTyping, done)
+		close(returned)
+	}()
+	<-started
+	close(done)
+	select {
+	case <-returned:
+	case <-time.After(2 * time.Second):
+		t.Fatal("KeepChatAction did not return after done was closed")
+	}
+}
@@ -1,109 +1,369 @@
 package telegrambot
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
-	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBaseURL adalah alamat Bot API resmi Telegram
+const DefaultBaseURL = "https://api.telegram.org"
+
+const (
+	// defaultTimeout dipakai ketika Bot tidak diberi HTTPClient sendiri
+	defaultTimeout = 30 * time.Second
+	// defaultMaxRetryWait dipakai ketika MaxRetryWait bernilai nol
+	defaultMaxRetryWait = 30 * time.Second
+	// pollTimeoutMargin ditambahkan ke timeout long polling agar client tidak memutus request lebih dulu
+	pollTimeoutMargin = 10 * time.Second
+	// formContentType adalah content type untuk body form biasa
+	formContentType = "application/x-www-form-urlencoded"
+	// jsonContentType adalah content type untuk body JSON
+	jsonContentType = "application/json"
 )
 
 // Bot struct untuk menyimpan token bot
 type Bot struct {
 	Token string
+	// BaseURL menggantikan DefaultBaseURL, misalnya untuk httptest.Server atau Local Bot API Server
+	BaseURL string
+	// LocalMode diaktifkan bersama BaseURL jika memakai Local Bot API Server: file_path dari
+	// getFile dibaca langsung dari disk dan batas upload naik menjadi 2000 MB
+	LocalMode bool
+	// HTTPClient dipakai untuk semua request ke API; jika nil dipakai client dengan timeout 30 detik
+	HTTPClient *http.Client
+	// MaxRetries adalah jumlah percobaan ulang ketika API membalas 429 (0 = tanpa retry)
+	MaxRetries int
+	// MaxRetryWait membatasi lama tunggu retry_after; jika nol dipakai 30 detik
+	MaxRetryWait time.Duration
+	// CallTimeout, jika diisi, membatasi setiap request non-polling (kirim, edit, upload, ...)
+	// lewat deadline context, dihitung setelah antrean rate limiter. Timeout HTTPClient tetap
+	// berlaku, jadi yang lebih pendek menang. getUpdates tidak terkena CallTimeout: long polling
+	// memakai timeout-nya sendiri, yaitu timeout poll ditambah margin 10 detik.
+	CallTimeout time.Duration
+	// WebhookSecret dicocokkan dengan header X-Telegram-Bot-Api-Secret-Token oleh WebhookHandler;
+	// isi dengan nilai yang sama seperti WebhookConfig.SecretToken
+	WebhookSecret string
+
+	limiter  *rateLimiter
+	logger   Logger
+	observer Observer
+	dedup    dedupState
+
+	selfMu sync.RWMutex
+	self   *User
+}
+
+// NewBot membuat instance baru dari Bot
+func NewBot(token string) *Bot {
+	return &Bot{
+		Token: token,
+	}
 }
 
-// Update struct untuk mem-parsing pembaruan yang diterima
-type Update struct {
-	UpdateID int           `json:"update_id"`
-	Message  MessageStruct `json:"message"`
+// NewBotWithClient membuat instance baru dari Bot dengan http.Client kustom (proxy, TLS, timeout)
+func NewBotWithClient(token string, client *http.Client) *Bot {
+	return &Bot{
+		Token:      token,
+		HTTPClient: client,
+	}
 }
 
-// MessageStruct untuk mem-parsing pesan
-type MessageStruct struct {
-	MessageID int      `json:"message_id"`
-	From      User     `json:"from"`
-	Chat      Chat     `json:"chat"`
-	Date      int      `json:"date"`
-	Text      string   `json:"text"`
-	Entities  []Entity `json:"entities"`
+var defaultClient = &http.Client{Timeout: defaultTimeout}
+
+// client mengembalikan http.Client yang dipakai oleh bot
+func (b *Bot) client() *http.Client {
+	if b.HTTPClient != nil {
+		return b.HTTPClient
+	}
+	return defaultClient
 }
 
-// User struct untuk mem-parsing informasi pengguna
-type User struct {
-	ID        int    `json:"id"`
-	IsBot     bool   `json:"is_bot"`
-	FirstName string `json:"first_name"`
-	Username  string `json:"username"`
+// pollClient mengembalikan client yang timeout-nya lebih lama dari timeout long polling
+func (b *Bot) pollClient(timeout int) *http.Client {
+	c := b.client()
+	need := time.Duration(timeout)*time.Second + pollTimeoutMargin
+	if timeout <= 0 || c.Timeout == 0 || c.Timeout >= need {
+		return c
+	}
+	extended := *c
+	extended.Timeout = need
+	return &extended
 }
 
-// Chat struct untuk mem-parsing informasi chat
-type Chat struct {
-	ID   int64  `json:"id"`
-	Type string `json:"type"`
+// requestBody adalah body request. open dipanggil untuk setiap percobaan; body yang tidak
+// replayable (misalnya upload dari io.Reader) tidak pernah diulang.
+type requestBody struct {
+	contentType string
+	open        func() io.Reader
+	replayable  bool
 }
 
-// Entity struct untuk mem-parsing entitas pesan
-type Entity struct {
-	Offset int    `json:"offset"`
-	Length int    `json:"length"`
-	Type   string `json:"type"`
+// bytesBody membuat requestBody dari data di memori
+func bytesBody(contentType string, data []byte) requestBody {
+	return requestBody{
+		contentType: contentType,
+		open:        func() io.Reader { return bytes.NewReader(data) },
+		replayable:  true,
+	}
 }
 
-// NewBot membuat instance baru dari Bot
-func NewBot(token string) *Bot {
-	return &Bot{
-		Token: token,
+// post mengirim body POST ke method API dengan context yang diberikan.
+// Jika API membalas 429, request diulang sesuai retry_after hingga MaxRetries kali.
+// started adalah waktu mulai percobaan terakhir; percobaan yang diulang dilaporkan ke Observer di sini.
+func (b *Bot) post(ctx context.Context, client *http.Client, method string, body requestBody) (*http.Response, time.Time, error) {
+	for attempt := 0; ; attempt++ {
+		started := time.Now()
+		b.debugf("telegram: %s: sending request", method)
+		r := body.open()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.methodURL(method), r)
+		if err != nil {
+			closePipe(r, err)
+			return nil, started, err
+		}
+		req.Header.Set("Content-Type", body.contentType)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			closePipe(r, err)
+			if ctx.Err() != nil {
+				return nil, started, ctx.Err()
+			}
+			return nil, started, err
+		}
+		b.debugf("telegram: %s: HTTP %d", method, resp.StatusCode)
+		if resp.StatusCode != http.StatusTooManyRequests || attempt >= b.MaxRetries || !body.replayable {
+			return resp, started, nil
+		}
+
+		apiErr := parseAPIError(resp).(*APIError)
+		resp.Body.Close()
+		b.observe(method, started, apiErr)
+		b.debugf("telegram: %s: rate limited, retry %d/%d after %ds", method, attempt+1, b.MaxRetries, apiErr.Parameters.RetryAfter)
+		if err := b.waitRetry(ctx, apiErr); err != nil {
+			return nil, started, err
+		}
+	}
+}
+
+// closePipe menutup reader upload multipart yang tidak jadi dibaca sehingga goroutine
+// penulisnya berhenti
+func closePipe(r io.Reader, err error) {
+	if pr, ok := r.(*io.PipeReader); ok {
+		pr.CloseWithError(err)
 	}
 }
 
-// SendMessage mengirim pesan ke chat tertentu
-func (b *Bot) SendMessage(chatID int64, text string) error {
-	apiURL := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", b.Token)
-	data := url.Values{}
-	data.Set("chat_id", strconv.FormatInt(chatID, 10))
-	data.Set("text", text)
+// waitRetry menunggu sesuai retry_after (dibatasi MaxRetryWait) atau sampai ctx dibatalkan
+func (b *Bot) waitRetry(ctx context.Context, apiErr *APIError) error {
+	maxWait := b.MaxRetryWait
+	if maxWait <= 0 {
+		maxWait = defaultMaxRetryWait
+	}
+	wait := time.Duration(apiErr.Parameters.RetryAfter) * time.Second
+	if wait > maxWait {
+		wait = maxWait
+	}
+	if !sleepContext(ctx, wait) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// apiResponse adalah amplop response standar dari API Telegram
+type apiResponse struct {
+	Ok          bool               `json:"ok"`
+	Result      json.RawMessage    `json:"result"`
+	ErrorCode   int                `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
 
-	resp, err := http.PostForm(apiURL, data)
+// setJSON men-serialize v sebagai JSON ke field form key (reply_markup, media, ...) untuk request multipart
+func setJSON(data url.Values, key string, v interface{}) error {
+	encoded, err := json.Marshal(v)
 	if err != nil {
+		return fmt.Errorf("telegram: encode %s: %w", key, err)
+	}
+	data.Set(key, string(encoded))
+	return nil
+}
+
+// baseURL mengembalikan BaseURL tanpa garis miring di akhir, atau DefaultBaseURL jika kosong
+func (b *Bot) baseURL() string {
+	if b.BaseURL == "" {
+		return DefaultBaseURL
+	}
+	return strings.TrimRight(b.BaseURL, "/")
+}
+
+// methodURL membangun URL endpoint untuk method API
+func (b *Bot) methodURL(method string) string {
+	return fmt.Sprintf("%s/bot%s/%s", b.baseURL(), b.Token, method)
+}
+
+// doRequest memanggil method API dengan params, memeriksa ok, lalu men-decode result ke out.
+// Response yang gagal dikembalikan sebagai *APIError.
+func (b *Bot) doRequest(ctx context.Context, method string, params url.Values, out interface{}) error {
+	if err := b.waitRateLimit(ctx, method, params.Get("chat_id")); err != nil {
 		return err
 	}
-	defer resp.Body.Close()
+	ctx, cancel := b.callContext(ctx)
+	defer cancel()
+	return b.call(ctx, b.client(), method, bytesBody(formContentType, []byte(params.Encode())), out)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := ioutil.ReadAll(resp.Body)
-		return fmt.Errorf("failed to send message: %s", string(bodyBytes))
+// doJSONRequest seperti doRequest tetapi params di-serialize utuh sebagai body JSON,
+// sehingga objek bertingkat (reply_markup, entities, ...) tidak perlu di-encode terpisah.
+// Method dengan upload file tetap memakai doUpload (multipart).
+func (b *Bot) doJSONRequest(ctx context.Context, method string, params interface{}, out interface{}) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("telegram: %s: encode params: %w", method, err)
 	}
+	if err := b.waitRateLimit(ctx, method, jsonChatID(body)); err != nil {
+		return err
+	}
+	ctx, cancel := b.callContext(ctx)
+	defer cancel()
+	return b.call(ctx, b.client(), method, bytesBody(jsonContentType, body), out)
+}
 
-	return nil
+// callContext menurunkan ctx dengan deadline CallTimeout untuk request non-polling
+func (b *Bot) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if b.CallTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, b.CallTimeout)
 }
 
-// GetUpdates mengambil pembaruan baru dari API Telegram
-func (b *Bot) GetUpdates(offset int) ([]Update, error) {
-	apiURL := fmt.Sprintf("https://api.telegram.org/bot%s/getUpdates", b.Token)
-	data := url.Values{}
-	data.Set("offset", strconv.Itoa(offset))
+// jsonChatID mengambil chat_id dari body JSON untuk rate limiter
+func jsonChatID(body []byte) string {
+	var probe struct {
+		ChatID json.RawMessage `json:"chat_id"`
+	}
+	if json.Unmarshal(body, &probe) != nil {
+		return ""
+	}
+	return strings.Trim(string(probe.ChatID), `"`)
+}
 
-	resp, err := http.PostForm(apiURL, data)
+// waitRateLimit menunggu rate limiter (jika diaktifkan dengan SetRateLimits) untuk chatID
+func (b *Bot) waitRateLimit(ctx context.Context, method, chatID string) error {
+	if b.limiter == nil {
+		return nil
+	}
+	if err := b.limiter.wait(ctx, method, chatID); err != nil {
+		return fmt.Errorf("telegram: %s: %w", method, err)
+	}
+	return nil
+}
+
+// call mengirim body ke method API memakai client tertentu lalu men-decode response-nya
+func (b *Bot) call(ctx context.Context, client *http.Client, method string, body requestBody, out interface{}) error {
+	resp, started, err := b.post(ctx, client, method, body)
 	if err != nil {
-		return nil, err
+		err = fmt.Errorf("telegram: %s: %w", method, redactError(err))
+		b.observe(method, started, err)
+		return err
 	}
 	defer resp.Body.Close()
 
+	err = decodeResponse(resp, method, out)
+	b.observe(method, started, err)
+	return err
+}
+
+// decodeResponse men-decode amplop response dan menerjemahkan kegagalan menjadi *APIError
+func decodeResponse(resp *http.Response, method string, out interface{}) error {
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := ioutil.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get updates: %s", string(bodyBytes))
+		return parseAPIError(resp)
 	}
 
-	var updatesResp struct {
-		Ok     bool     `json:"ok"`
-		Result []Update `json:"result"`
+	var apiResp apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+		return fmt.Errorf("telegram: %s: decode response: %w", method, err)
 	}
-	err = json.NewDecoder(resp.Body).Decode(&updatesResp)
+	if !apiResp.Ok {
+		return &APIError{
+			Code:        apiResp.ErrorCode,
+			Description: apiResp.Description,
+			Parameters:  apiResp.Parameters,
+		}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(apiResp.Result, out); err != nil {
+		return fmt.Errorf("telegram: %s: decode result: %w", method, err)
+	}
+	return nil
+}
+
+// GetUpdates mengambil pembaruan baru dari API Telegram
+func (b *Bot) GetUpdates(offset int) ([]Update, error) {
+	return b.GetUpdatesContext(context.Background(), offset)
+}
+
+// GetUpdatesContext sama dengan GetUpdates tetapi dapat dibatalkan lewat ctx
+func (b *Bot) GetUpdatesContext(ctx context.Context, offset int) ([]Update, error) {
+	return b.GetUpdatesWithConfig(ctx, UpdateConfig{Offset: offset})
+}
+
+// GetUpdatesTimeout mengambil pembaruan dengan long polling: request ditahan server hingga
+// timeout detik sampai ada update baru. Timeout client diperpanjang bila perlu.
+func (b *Bot) GetUpdatesTimeout(ctx context.Context, offset, timeout int) ([]Update, error) {
+	return b.GetUpdatesWithConfig(ctx, UpdateConfig{Offset: offset, Timeout: timeout})
+}
+
+// Jenis update untuk UpdateConfig.AllowedUpdates
+const (
+	UpdateTypeMessage            = "message"
+	UpdateTypeEditedMessage      = "edited_message"
+	UpdateTypeChannelPost        = "channel_post"
+	UpdateTypeEditedChannelPost  = "edited_channel_post"
+	UpdateTypeInlineQuery        = "inline_query"
+	UpdateTypeChosenInlineResult = "chosen_inline_result"
+	UpdateTypeCallbackQuery      = "callback_query"
+	UpdateTypeShippingQuery      = "shipping_query"
+	UpdateTypePreCheckoutQuery   = "pre_checkout_query"
+	UpdateTypePoll               = "poll"
+	UpdateTypePollAnswer         = "poll_answer"
+	UpdateTypeMyChatMember       = "my_chat_member"
+	UpdateTypeChatMember         = "chat_member"
+	UpdateTypeChatJoinRequest    = "chat_join_request"
+	UpdateTypeMessageReaction    = "message_reaction"
+)
+
+// UpdateConfig berisi parameter getUpdates. Field yang bernilai nol tidak dikirim.
+type UpdateConfig struct {
+	Offset         int      `json:"offset"`
+	Limit          int      `json:"limit,omitempty"`   // 1-100
+	Timeout        int      `json:"timeout,omitempty"` // timeout long polling dalam detik
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+// GetUpdatesWithConfig mengambil pembaruan sesuai UpdateConfig
+func (b *Bot) GetUpdatesWithConfig(ctx context.Context, cfg UpdateConfig) ([]Update, error) {
+	if cfg.Timeout < 0 {
+		cfg.Timeout = 0
+	}
+	encoded, err := json.Marshal(cfg)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("telegram: encode getUpdates: %w", err)
 	}
 
-	return updatesResp.Result, nil
+	var updates []Update
+	body := bytesBody(jsonContentType, encoded)
+	if err := b.call(ctx, b.pollClient(cfg.Timeout), "getUpdates", body, &updates); err != nil {
+		return nil, err
+	}
+	return updates, nil
 }
@@ -0,0 +1,19 @@
+package telegrambot_test
+
+import (
+	"testing"
+
+	telegrambot "github.com/VampXDH/telegram-bot-package"
+)
+
+func TestNewBot(t *testing.T) {
+	bot := telegrambot.NewBot("123:abc")
+	if bot.Token != "123:abc" {
+		t.Fatalf("Token = %q, want %q", bot.Token, "123:abc")
+	}
+
+	var msg telegrambot.MessageStruct = telegrambot.Message{MessageID: 1}
+	if msg.MessageID != 1 {
+		t.Fatalf("MessageStruct is not an alias of Message")
+	}
+}
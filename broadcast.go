@@ -0,0 +1,38 @@
+package telegrambot
+
+import "context"
+
+// BroadcastResult adalah hasil pengiriman ke satu chat oleh Broadcast
+type BroadcastResult struct {
+	ChatID  int64
+	Message *Message // nil jika gagal
+	Err     error    // misalnya *APIError 403 jika bot diblokir pengguna
+}
+
+// Broadcast mengirim text ke setiap chat secara berurutan memakai cfg sebagai template
+// (cfg.ChatID, cfg.Chat dan cfg.Text diabaikan). Kegagalan di satu chat, misalnya bot diblokir,
+// dicatat di hasil tanpa menghentikan pengiriman ke chat lain. Pengiriman dibatasi oleh
+// rate limiter bot; jika SetRateLimits belum dipanggil, dipakai batas default Telegram.
+func (b *Bot) Broadcast(chatIDs []int64, text string, cfg SendMessageConfig) []BroadcastResult {
+	ctx := context.Background()
+
+	// setiap chat hanya menerima satu pesan, jadi cukup batas global
+	var pace *bucket
+	if b.limiter == nil {
+		pace = newBucket(DefaultGlobalRateLimit)
+	}
+
+	results := make([]BroadcastResult, 0, len(chatIDs))
+	for _, chatID := range chatIDs {
+		msgCfg := cfg
+		msgCfg.ChatID = chatID
+		msgCfg.Chat = ChatID{}
+		msgCfg.Text = text
+
+		result := BroadcastResult{ChatID: chatID}
+		_ = pace.wait(ctx)
+		result.Message, result.Err = b.SendContext(ctx, msgCfg)
+		results = append(results, result)
+	}
+	return results
+}
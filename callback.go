@@ -0,0 +1,59 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CallbackAnswer berisi parameter opsional answerCallbackQuery. Field yang bernilai nol tidak dikirim.
+type CallbackAnswer struct {
+	Text      string // text, notifikasi yang ditampilkan ke pengguna (0-200 karakter)
+	ShowAlert bool   // show_alert, tampilkan sebagai alert alih-alih notifikasi
+	URL       string // url yang dibuka oleh client: URL game atau t.me/bot?start=... untuk alur login
+	CacheTime int    // cache_time dalam detik; client menyimpan jawaban dan tidak menanyakan ulang selama itu
+}
+
+// params memvalidasi opsi lalu mengubahnya menjadi form values
+func (a CallbackAnswer) params(callbackID string) (url.Values, error) {
+	if a.ShowAlert && a.URL != "" {
+		return nil, fmt.Errorf("telegram: answerCallbackQuery: ShowAlert and URL cannot be combined")
+	}
+	if a.CacheTime < 0 {
+		return nil, fmt.Errorf("telegram: answerCallbackQuery: negative CacheTime %d", a.CacheTime)
+	}
+
+	data := url.Values{}
+	data.Set("callback_query_id", callbackID)
+	if a.Text != "" {
+		data.Set("text", a.Text)
+	}
+	if a.ShowAlert {
+		data.Set("show_alert", "true")
+	}
+	if a.URL != "" {
+		data.Set("url", a.URL)
+	}
+	setOptionalInt(data, "cache_time", a.CacheTime)
+	return data, nil
+}
+
+// AnswerCallbackQuery menjawab penekanan tombol inline sehingga spinner di client berhenti.
+// Setiap CallbackQuery harus dijawab, misalnya:
+//
+//	kb := telegrambot.NewInlineKeyboard().
+//		Row(telegrambot.NewInlineButtonData("Like", "like")).
+//		Build()
+//	bot.Send(telegrambot.SendMessageConfig{ChatID: chatID, Text: "Suka?", ReplyMarkup: kb})
+//
+//	// di loop update:
+//	if q := update.CallbackQuery; q != nil && q.Data == "like" {
+//		bot.AnswerCallbackQuery(q.ID, telegrambot.CallbackAnswer{Text: "Terima kasih!"})
+//	}
+func (b *Bot) AnswerCallbackQuery(callbackID string, opts CallbackAnswer) error {
+	data, err := opts.params(callbackID)
+	if err != nil {
+		return err
+	}
+	return b.doRequest(context.Background(), "answerCallbackQuery", data, nil)
+}
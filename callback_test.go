@@ -0,0 +1,47 @@
+package telegrambot
+
+import "testing"
+
+func TestCallbackAnswerParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		answer  CallbackAnswer
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "cache time",
+			answer: CallbackAnswer{Text: "Tersimpan", CacheTime: 30},
+			want:   map[string]string{"callback_query_id": "q", "text": "Tersimpan", "cache_time": "30"},
+		},
+		{
+			name:   "game url",
+			answer: CallbackAnswer{URL: "https://example.com/game"},
+			want:   map[string]string{"callback_query_id": "q", "url": "https://example.com/game"},
+		},
+		{name: "alert with url", answer: CallbackAnswer{Text: "x", ShowAlert: true, URL: "https://example.com"}, wantErr: true},
+		{name: "negative cache time", answer: CallbackAnswer{CacheTime: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.answer.params("q")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("params() should fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("params: %v", err)
+			}
+			if len(data) != len(tt.want) {
+				t.Errorf("params = %v, want %v", data, tt.want)
+			}
+			for k, v := range tt.want {
+				if data.Get(k) != v {
+					t.Errorf("%s = %q, want %q", k, data.Get(k), v)
+				}
+			}
+		})
+	}
+}
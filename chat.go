@@ -0,0 +1,112 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Status anggota chat pada ChatMember.Status
+const (
+	ChatMemberCreator       = "creator"
+	ChatMemberAdministrator = "administrator"
+	ChatMemberMember        = "member"
+	ChatMemberRestricted    = "restricted"
+	ChatMemberLeft          = "left"
+	ChatMemberKicked        = "kicked"
+)
+
+// chatParams membuat form values yang hanya berisi chat_id
+func chatParams(chatID int64) url.Values {
+	data := url.Values{}
+	data.Set("chat_id", strconv.FormatInt(chatID, 10))
+	return data
+}
+
+// chatUserParams membuat form values berisi chat_id dan user_id
+func chatUserParams(chatID int64, userID int) url.Values {
+	data := chatParams(chatID)
+	data.Set("user_id", strconv.Itoa(userID))
+	return data
+}
+
+// GetChat mengambil informasi lengkap chat, termasuk deskripsi, invite link, dan permissions
+func (b *Bot) GetChat(chatID int64) (*Chat, error) {
+	var chat Chat
+	if err := b.doRequest(context.Background(), "getChat", chatParams(chatID), &chat); err != nil {
+		return nil, err
+	}
+	return &chat, nil
+}
+
+// GetChatMember mengambil status keanggotaan user di chat
+func (b *Bot) GetChatMember(chatID int64, userID int) (*ChatMember, error) {
+	var member ChatMember
+	if err := b.doRequest(context.Background(), "getChatMember", chatUserParams(chatID, userID), &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// GetChatMemberCount mengambil jumlah anggota chat
+func (b *Bot) GetChatMemberCount(chatID int64) (int, error) {
+	var count int
+	if err := b.doRequest(context.Background(), "getChatMemberCount", chatParams(chatID), &count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SetChatTitle mengganti judul chat. Bot harus admin dengan hak can_change_info; jika tidak,
+// *APIError 400 "not enough rights" dikembalikan.
+func (b *Bot) SetChatTitle(chatID int64, title string) error {
+	data := chatParams(chatID)
+	data.Set("title", title)
+	return b.doRequest(context.Background(), "setChatTitle", data, nil)
+}
+
+// SetChatDescription mengganti deskripsi grup atau channel (0-255 karakter)
+func (b *Bot) SetChatDescription(chatID int64, description string) error {
+	data := chatParams(chatID)
+	data.Set("description", description)
+	return b.doRequest(context.Background(), "setChatDescription", data, nil)
+}
+
+// SetChatPhoto mengganti foto chat. Foto harus di-upload (path atau reader); URL dan
+// file_id tidak diterima Telegram untuk method ini.
+func (b *Bot) SetChatPhoto(chatID int64, photo InputFile) error {
+	if !photo.needsUpload() {
+		return fmt.Errorf("telegram: setChatPhoto: photo must be uploaded from a path or reader")
+	}
+	return b.doUpload(context.Background(), "setChatPhoto", chatParams(chatID), []uploadFile{{field: "photo", file: photo}}, nil)
+}
+
+// DeleteChatPhoto menghapus foto chat
+func (b *Bot) DeleteChatPhoto(chatID int64) error {
+	return b.doRequest(context.Background(), "deleteChatPhoto", chatParams(chatID), nil)
+}
+
+// GetChatAdministrators mengambil daftar admin chat (status "creator" atau "administrator").
+// Bot lain tidak termasuk dalam daftar.
+func (b *Bot) GetChatAdministrators(chatID int64) ([]ChatMember, error) {
+	var admins []ChatMember
+	if err := b.doRequest(context.Background(), "getChatAdministrators", chatParams(chatID), &admins); err != nil {
+		return nil, err
+	}
+	return admins, nil
+}
+
+// IsChatAdmin melaporkan apakah userID adalah pembuat atau admin chat
+func (b *Bot) IsChatAdmin(chatID int64, userID int) (bool, error) {
+	admins, err := b.GetChatAdministrators(chatID)
+	if err != nil {
+		return false, err
+	}
+	for _, admin := range admins {
+		if admin.User.ID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
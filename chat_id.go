@@ -0,0 +1,52 @@
+package telegrambot
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// ChatID menunjuk sebuah chat: id numerik atau @username untuk channel dan supergroup publik
+type ChatID struct {
+	ID       int64
+	Username string
+}
+
+// ChatIDInt membuat ChatID dari id numerik
+func ChatIDInt(id int64) ChatID {
+	return ChatID{ID: id}
+}
+
+// ChatUsername membuat ChatID dari username; awalan @ ditambahkan jika belum ada
+func ChatUsername(username string) ChatID {
+	if !strings.HasPrefix(username, "@") {
+		username = "@" + username
+	}
+	return ChatID{Username: username}
+}
+
+// IsZero melaporkan apakah c tidak berisi id maupun username
+func (c ChatID) IsZero() bool {
+	return c.ID == 0 && c.Username == ""
+}
+
+// String mengembalikan nilai field chat_id: username jika diisi, selain itu id numerik
+func (c ChatID) String() string {
+	if c.Username != "" {
+		return c.Username
+	}
+	return strconv.FormatInt(c.ID, 10)
+}
+
+// chatIDValue memilih chat (jika diisi) atau id numerik sebagai nilai chat_id
+func chatIDValue(id int64, chat ChatID) string {
+	if !chat.IsZero() {
+		return chat.String()
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// SendMessageTo mengirim pesan teks ke ChatID, misalnya ChatUsername("@namachannel")
+func (b *Bot) SendMessageTo(chat ChatID, text string) (*Message, error) {
+	return b.SendContext(context.Background(), SendMessageConfig{Chat: chat, Text: text})
+}
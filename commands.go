@@ -0,0 +1,67 @@
+package telegrambot
+
+import "context"
+
+// Jenis scope untuk CommandScope.Type
+const (
+	ScopeDefault               = "default"
+	ScopeAllPrivateChats       = "all_private_chats"
+	ScopeAllGroupChats         = "all_group_chats"
+	ScopeAllChatAdministrators = "all_chat_administrators"
+	ScopeChat                  = "chat"
+	ScopeChatAdministrators    = "chat_administrators"
+	ScopeChatMember            = "chat_member"
+)
+
+// CommandScope menentukan kepada siapa daftar command berlaku. Nilai nol berarti scope default.
+// LanguageCode tidak termasuk scope tetapi dikirim sebagai language_code.
+type CommandScope struct {
+	Type         string `json:"type"`
+	ChatID       int64  `json:"chat_id,omitempty"` // untuk ScopeChat, ScopeChatAdministrators, ScopeChatMember
+	UserID       int    `json:"user_id,omitempty"` // untuk ScopeChatMember
+	LanguageCode string `json:"-"`
+}
+
+// scopeRequest adalah bagian body JSON berisi scope dan language_code
+type scopeRequest struct {
+	Scope        *CommandScope `json:"scope,omitempty"`
+	LanguageCode string        `json:"language_code,omitempty"`
+}
+
+// request mengubah scope menjadi scopeRequest; Type kosong berarti scope default tidak dikirim
+func (s CommandScope) request() scopeRequest {
+	req := scopeRequest{LanguageCode: s.LanguageCode}
+	if s.Type != "" {
+		req.Scope = &s
+	}
+	return req
+}
+
+// setMyCommandsRequest adalah body JSON setMyCommands
+type setMyCommandsRequest struct {
+	Commands []BotCommand `json:"commands"`
+	scopeRequest
+}
+
+// SetMyCommands mengatur daftar command bot untuk scope tertentu
+func (b *Bot) SetMyCommands(commands []BotCommand, opts CommandScope) error {
+	if commands == nil {
+		commands = []BotCommand{}
+	}
+	req := setMyCommandsRequest{Commands: commands, scopeRequest: opts.request()}
+	return b.doJSONRequest(context.Background(), "setMyCommands", req, nil)
+}
+
+// GetMyCommands mengambil daftar command bot untuk scope tertentu
+func (b *Bot) GetMyCommands(opts CommandScope) ([]BotCommand, error) {
+	var commands []BotCommand
+	if err := b.doJSONRequest(context.Background(), "getMyCommands", opts.request(), &commands); err != nil {
+		return nil, err
+	}
+	return commands, nil
+}
+
+// DeleteMyCommands menghapus daftar command bot untuk scope tertentu
+func (b *Bot) DeleteMyCommands(opts CommandScope) error {
+	return b.doJSONRequest(context.Background(), "deleteMyCommands", opts.request(), nil)
+}
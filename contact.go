@@ -0,0 +1,34 @@
+package telegrambot
+
+import "context"
+
+// ContactOptions berisi parameter opsional sendContact. Field yang bernilai nol tidak dikirim.
+type ContactOptions struct {
+	LastName            string      `json:"last_name,omitempty"`
+	VCard               string      `json:"vcard,omitempty"`             // data tambahan dalam format vCard (0-2048 byte)
+	MessageThreadID     int         `json:"message_thread_id,omitempty"` // topik forum tujuan
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ProtectContent      bool        `json:"protect_content,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// sendContactRequest adalah body JSON sendContact
+type sendContactRequest struct {
+	ChatID      int64  `json:"chat_id"`
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	ContactOptions
+}
+
+// SendContact mengirim kartu kontak. Untuk meminta nomor pengguna sendiri, kirim reply
+// keyboard berisi NewKeyboardButtonContact; kontak yang dibagikan ada di Message.Contact.
+func (b *Bot) SendContact(chatID int64, phoneNumber, firstName string, opts ContactOptions) (*Message, error) {
+	req := sendContactRequest{ChatID: chatID, PhoneNumber: phoneNumber, FirstName: firstName, ContactOptions: opts}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "sendContact", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
@@ -0,0 +1,206 @@
+package telegrambot
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultConversationTimeout adalah batas diam sebelum percakapan dianggap ditinggalkan
+const DefaultConversationTimeout = 15 * time.Minute
+
+// ConversationKey mengidentifikasi percakapan satu user di satu chat
+type ConversationKey struct {
+	ChatID int64
+	UserID int
+}
+
+// ConversationState adalah state percakapan yang disimpan di ConversationStore. Data berupa
+// string agar mudah di-serialize ke store eksternal seperti Redis.
+type ConversationState struct {
+	Step      string
+	Data      map[string]string
+	UpdatedAt time.Time
+}
+
+// ConversationStore menyimpan state percakapan. Implementasi harus aman dipakai bersamaan.
+type ConversationStore interface {
+	Get(key ConversationKey) (ConversationState, bool)
+	Set(key ConversationKey, state ConversationState)
+	Delete(key ConversationKey)
+}
+
+// MemoryConversationStore adalah ConversationStore di memori proses
+type MemoryConversationStore struct {
+	mu     sync.Mutex
+	states map[ConversationKey]ConversationState
+}
+
+// NewMemoryConversationStore membuat MemoryConversationStore kosong
+func NewMemoryConversationStore() *MemoryConversationStore {
+	return &MemoryConversationStore{states: make(map[ConversationKey]ConversationState)}
+}
+
+// Get mengembalikan state untuk key
+func (s *MemoryConversationStore) Get(key ConversationKey) (ConversationState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.states[key]
+	return state, ok
+}
+
+// Set menyimpan state untuk key
+func (s *MemoryConversationStore) Set(key ConversationKey, state ConversationState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[key] = state
+}
+
+// Delete menghapus state untuk key
+func (s *MemoryConversationStore) Delete(key ConversationKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.states, key)
+}
+
+// Conversation adalah percakapan aktif yang diberikan ke StepHandler. Perubahan disimpan
+// setelah handler selesai.
+type Conversation struct {
+	Key   ConversationKey
+	state ConversationState
+	ended bool
+}
+
+// Step mengembalikan nama langkah yang sedang berjalan
+func (c *Conversation) Step() string {
+	return c.state.Step
+}
+
+// Next menentukan langkah yang menerima pesan berikutnya dari user
+func (c *Conversation) Next(step string) {
+	c.state.Step = step
+	c.ended = false
+}
+
+// End mengakhiri percakapan; pesan berikutnya kembali ditangani handler biasa
+func (c *Conversation) End() {
+	c.ended = true
+}
+
+// Get mengambil data percakapan
+func (c *Conversation) Get(key string) string {
+	return c.state.Data[key]
+}
+
+// Set menyimpan data percakapan, misalnya jawaban langkah sebelumnya
+func (c *Conversation) Set(key, value string) {
+	if c.state.Data == nil {
+		c.state.Data = make(map[string]string)
+	}
+	c.state.Data[key] = value
+}
+
+// StepHandler menangani satu pesan user di langkah percakapan tertentu
+type StepHandler func(bot *Bot, msg *Message, conv *Conversation)
+
+// Conversations mengarahkan pesan user yang sedang dalam percakapan ke handler langkahnya.
+// Pasang sebagai middleware Dispatcher:
+//
+//	convs := telegrambot.NewConversations(nil, 0)
+//	convs.Step("name", func(bot *telegrambot.Bot, msg *telegrambot.Message, c *telegrambot.Conversation) {
+//		c.Set("name", msg.Text)
+//		c.Next("age")
+//		bot.SendMessage(msg.Chat.ID, "Umur?")
+//	})
+//	convs.Step("age", func(bot *telegrambot.Bot, msg *telegrambot.Message, c *telegrambot.Conversation) {
+//		c.End()
+//		bot.SendMessage(msg.Chat.ID, "Halo "+c.Get("name")+", umur "+msg.Text)
+//	})
+//	d.Use(convs.Middleware)
+//	d.Command("daftar", func(bot *telegrambot.Bot, msg *telegrambot.Message) {
+//		convs.Start(msg.Chat.ID, msg.From.ID, "name")
+//		bot.SendMessage(msg.Chat.ID, "Nama?")
+//	})
+type Conversations struct {
+	store   ConversationStore
+	timeout time.Duration
+	now     func() time.Time
+
+	mu    sync.RWMutex
+	steps map[string]StepHandler
+}
+
+// NewConversations membuat Conversations. store nil memakai MemoryConversationStore;
+// timeout <= 0 memakai DefaultConversationTimeout.
+func NewConversations(store ConversationStore, timeout time.Duration) *Conversations {
+	if store == nil {
+		store = NewMemoryConversationStore()
+	}
+	if timeout <= 0 {
+		timeout = DefaultConversationTimeout
+	}
+	return &Conversations{store: store, timeout: timeout, now: time.Now, steps: make(map[string]StepHandler)}
+}
+
+// Step mendaftarkan handler untuk langkah name
+func (c *Conversations) Step(name string, handler StepHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.steps[name] = handler
+}
+
+// Start memulai (atau mengulang) percakapan user di chat pada langkah step
+func (c *Conversations) Start(chatID int64, userID int, step string) {
+	c.store.Set(ConversationKey{ChatID: chatID, UserID: userID}, ConversationState{Step: step, UpdatedAt: c.now()})
+}
+
+// Cancel menghentikan percakapan user di chat
+func (c *Conversations) Cancel(chatID int64, userID int) {
+	c.store.Delete(ConversationKey{ChatID: chatID, UserID: userID})
+}
+
+// active mengembalikan state percakapan yang masih berlaku; percakapan yang melewati timeout dihapus
+func (c *Conversations) active(key ConversationKey) (ConversationState, bool) {
+	state, ok := c.store.Get(key)
+	if !ok {
+		return state, false
+	}
+	if c.now().Sub(state.UpdatedAt) > c.timeout {
+		c.store.Delete(key)
+		return state, false
+	}
+	return state, true
+}
+
+// Middleware meneruskan pesan dari user yang sedang dalam percakapan ke handler langkahnya.
+// Update lain, percakapan yang kedaluwarsa, dan langkah tanpa handler diteruskan ke next.
+func (c *Conversations) Middleware(next HandlerFunc) HandlerFunc {
+	return func(bot *Bot, update Update) {
+		msg := &update.Message
+		if msg.MessageID == 0 || msg.From.ID == 0 {
+			next(bot, update)
+			return
+		}
+		key := ConversationKey{ChatID: msg.Chat.ID, UserID: msg.From.ID}
+		state, ok := c.active(key)
+		if !ok {
+			next(bot, update)
+			return
+		}
+		c.mu.RLock()
+		handler, found := c.steps[state.Step]
+		c.mu.RUnlock()
+		if !found {
+			next(bot, update)
+			return
+		}
+
+		conv := &Conversation{Key: key, state: state}
+		handler(bot, msg, conv)
+		if conv.ended {
+			c.store.Delete(key)
+			return
+		}
+		conv.state.UpdatedAt = c.now()
+		c.store.Set(key, conv.state)
+	}
+}
@@ -0,0 +1,58 @@
+package telegrambot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConversationSteps(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	convs := NewConversations(nil, time.Minute)
+	convs.now = func() time.Time { return now }
+
+	var replies []string
+	convs.Step("name", func(_ *Bot, msg *Message, c *Conversation) {
+		c.Set("name", msg.Text)
+		c.Next("age")
+	})
+	convs.Step("age", func(_ *Bot, msg *Message, c *Conversation) {
+		replies = append(replies, c.Get("name")+" "+msg.Text)
+		c.End()
+	})
+
+	d := NewDispatcher(NewBot("123:abc"))
+	d.Use(convs.Middleware)
+	d.Fallback(func(_ *Bot, msg *Message) { replies = append(replies, "fallback "+msg.Text) })
+
+	text := func(userID int, s string) Update {
+		return Update{Message: Message{MessageID: 1, From: User{ID: userID}, Chat: Chat{ID: 100}, Text: s}}
+	}
+
+	convs.Start(100, 7, "name")
+	d.Handle(text(7, "Budi"))
+	d.Handle(text(8, "bukan percakapan")) // user lain di chat yang sama tidak terpengaruh
+	d.Handle(text(7, "30"))
+	d.Handle(text(7, "selesai"))
+
+	want := []string{"fallback bukan percakapan", "Budi 30", "fallback selesai"}
+	if len(replies) != len(want) {
+		t.Fatalf("replies = %q, want %q", replies, want)
+	}
+	for i := range want {
+		if replies[i] != want[i] {
+			t.Fatalf("replies = %q, want %q", replies, want)
+		}
+	}
+
+	// percakapan yang diam melewati timeout ditinggalkan
+	replies = nil
+	convs.Start(100, 7, "name")
+	now = now.Add(2 * time.Minute)
+	d.Handle(text(7, "terlambat"))
+	if len(replies) != 1 || replies[0] != "fallback terlambat" {
+		t.Fatalf("stale conversation replies = %q", replies)
+	}
+	if _, ok := convs.store.Get(ConversationKey{ChatID: 100, UserID: 7}); ok {
+		t.Error("stale conversation should be deleted")
+	}
+}
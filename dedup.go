@@ -0,0 +1,137 @@
+package telegrambot
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultDedupTTL adalah lama key SendMessageOnce diingat jika SetDedupStore tidak diberi TTL
+const DefaultDedupTTL = 10 * time.Minute
+
+// DedupStore menyimpan hasil SendMessageOnce per key. Implementasi harus aman dipakai
+// bersamaan; untuk beberapa instance bot, backing store bersama seperti Redis dapat dipakai
+// dengan men-serialize Message sebagai JSON.
+type DedupStore interface {
+	// Get mengembalikan pesan untuk key yang belum kedaluwarsa
+	Get(key string) (*Message, bool)
+	// Set menyimpan pesan untuk key selama ttl
+	Set(key string, msg *Message, ttl time.Duration)
+}
+
+// MemoryDedupStore adalah DedupStore di memori proses; key kedaluwarsa dibuang saat Set
+type MemoryDedupStore struct {
+	mu      sync.Mutex
+	entries map[string]dedupEntry
+	now     func() time.Time
+}
+
+// dedupEntry adalah satu hasil yang tersimpan beserta waktu kedaluwarsanya
+type dedupEntry struct {
+	msg     *Message
+	expires time.Time
+}
+
+// NewMemoryDedupStore membuat MemoryDedupStore kosong
+func NewMemoryDedupStore() *MemoryDedupStore {
+	return &MemoryDedupStore{entries: make(map[string]dedupEntry), now: time.Now}
+}
+
+// Get mengembalikan pesan untuk key jika belum kedaluwarsa
+func (s *MemoryDedupStore) Get(key string) (*Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || !s.now().Before(e.expires) {
+		return nil, false
+	}
+	return e.msg, true
+}
+
+// Set menyimpan pesan untuk key selama ttl dan membuang key lain yang sudah kedaluwarsa
+func (s *MemoryDedupStore) Set(key string, msg *Message, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for k, e := range s.entries {
+		if !now.Before(e.expires) {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = dedupEntry{msg: msg, expires: now.Add(ttl)}
+}
+
+// dedupState berisi store SendMessageOnce dan key yang sedang dikirim
+type dedupState struct {
+	mu       sync.Mutex
+	store    DedupStore
+	ttl      time.Duration
+	inflight map[string]chan struct{}
+}
+
+// SetDedupStore memasang store untuk SendMessageOnce. ttl <= 0 memakai DefaultDedupTTL;
+// store nil kembali ke MemoryDedupStore.
+func (b *Bot) SetDedupStore(store DedupStore, ttl time.Duration) {
+	b.dedup.mu.Lock()
+	defer b.dedup.mu.Unlock()
+	b.dedup.store = store
+	b.dedup.ttl = ttl
+}
+
+// acquire menunggu sampai tidak ada pengiriman lain dengan key yang sama lalu mengembalikan
+// store, ttl, dan fungsi release
+func (d *dedupState) acquire(key string) (DedupStore, time.Duration, func()) {
+	d.mu.Lock()
+	for {
+		wait, busy := d.inflight[key]
+		if !busy {
+			break
+		}
+		d.mu.Unlock()
+		<-wait
+		d.mu.Lock()
+	}
+	if d.store == nil {
+		d.store = NewMemoryDedupStore()
+	}
+	if d.inflight == nil {
+		d.inflight = make(map[string]chan struct{})
+	}
+	done := make(chan struct{})
+	d.inflight[key] = done
+	store, ttl := d.store, d.ttl
+	d.mu.Unlock()
+
+	if ttl <= 0 {
+		ttl = DefaultDedupTTL
+	}
+	return store, ttl, func() {
+		d.mu.Lock()
+		delete(d.inflight, key)
+		d.mu.Unlock()
+		close(done)
+	}
+}
+
+// SendMessageOnce mengirim text hanya sekali untuk setiap key selama TTL dedup, misalnya
+// dengan key dari update_id agar webhook yang dikirim ulang tidak menghasilkan pesan ganda.
+// Jika key sudah pernah berhasil dikirim, pesan yang tersimpan dikembalikan tanpa request.
+// Pengiriman yang gagal tidak disimpan sehingga dapat dicoba lagi dengan key yang sama.
+//
+//	key := fmt.Sprintf("welcome:%d", update.UpdateID)
+//	bot.SendMessageOnce(key, chatID, "Selamat datang!")
+func (b *Bot) SendMessageOnce(key string, chatID int64, text string) (*Message, error) {
+	store, ttl, release := b.dedup.acquire(key)
+	defer release()
+
+	if msg, ok := store.Get(key); ok {
+		b.debugf("telegram: sendMessage: duplicate key %q, returning cached message", key)
+		return msg, nil
+	}
+	msg, err := b.SendContext(context.Background(), SendMessageConfig{ChatID: chatID, Text: text})
+	if err != nil {
+		return nil, err
+	}
+	store.Set(key, msg, ttl)
+	return msg, nil
+}
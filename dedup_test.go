@@ -0,0 +1,66 @@
+package telegrambot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSendMessageOnce(t *testing.T) {
+	var sent int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(`{"ok":true,"result":{"message_id":9}}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+
+	// pengiriman ulang bersamaan dengan key yang sama hanya menghasilkan satu request
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			msg, err := bot.SendMessageOnce("update:1", 1, "halo")
+			if err != nil || msg.MessageID != 9 {
+				t.Errorf("SendMessageOnce = %+v, %v", msg, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&sent); n != 1 {
+		t.Fatalf("sent %d requests, want 1", n)
+	}
+
+	if _, err := bot.SendMessageOnce("update:2", 1, "halo"); err != nil {
+		t.Fatalf("SendMessageOnce: %v", err)
+	}
+	if n := atomic.LoadInt32(&sent); n != 2 {
+		t.Fatalf("sent %d requests, want 2 after a new key", n)
+	}
+}
+
+func TestMemoryDedupStoreTTL(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	store := NewMemoryDedupStore()
+	store.now = func() time.Time { return now }
+
+	store.Set("k", &Message{MessageID: 1}, time.Minute)
+	if msg, ok := store.Get("k"); !ok || msg.MessageID != 1 {
+		t.Fatalf("Get before TTL = %+v, %v", msg, ok)
+	}
+	now = now.Add(time.Minute)
+	if _, ok := store.Get("k"); ok {
+		t.Fatal("Get after TTL should miss")
+	}
+	store.Set("other", &Message{}, time.Minute)
+	if _, ok := store.entries["k"]; ok {
+		t.Error("expired key should be pruned on Set")
+	}
+}
@@ -0,0 +1,32 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxDeleteMessages adalah jumlah maksimum pesan per panggilan deleteMessages
+const maxDeleteMessages = 100
+
+// DeleteMessage menghapus pesan. Pesan yang lebih dari 48 jam atau tidak boleh dihapus
+// oleh bot menghasilkan *APIError 400 ("message can't be deleted"), dan pesan yang sudah
+// tidak ada menghasilkan *APIError 400 ("message to delete not found").
+func (b *Bot) DeleteMessage(chatID int64, messageID int) error {
+	return b.doRequest(context.Background(), "deleteMessage", chatMessageParams(chatID, messageID), nil)
+}
+
+// deleteMessagesRequest adalah body JSON deleteMessages
+type deleteMessagesRequest struct {
+	ChatID     int64 `json:"chat_id"`
+	MessageIDs []int `json:"message_ids"`
+}
+
+// DeleteMessages menghapus hingga 100 pesan sekaligus; pesan yang tidak bisa dihapus dilewati
+func (b *Bot) DeleteMessages(chatID int64, messageIDs []int) error {
+	if len(messageIDs) == 0 || len(messageIDs) > maxDeleteMessages {
+		return fmt.Errorf("telegram: deleteMessages: need 1-%d message ids, got %d", maxDeleteMessages, len(messageIDs))
+	}
+
+	req := deleteMessagesRequest{ChatID: chatID, MessageIDs: messageIDs}
+	return b.doJSONRequest(context.Background(), "deleteMessages", req, nil)
+}
@@ -0,0 +1,48 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+)
+
+// Emoji yang diterima SendDice
+const (
+	DiceEmojiDice        = "🎲"
+	DiceEmojiDarts       = "🎯"
+	DiceEmojiBasketball  = "🏀"
+	DiceEmojiFootball    = "⚽"
+	DiceEmojiBowling     = "🎳"
+	DiceEmojiSlotMachine = "🎰"
+)
+
+// sendDiceRequest adalah body JSON sendDice
+type sendDiceRequest struct {
+	ChatID int64  `json:"chat_id"`
+	Emoji  string `json:"emoji"`
+	SendOptions
+}
+
+// SendDice mengirim emoji animasi dengan nilai acak; emoji kosong berarti 🎲.
+// Nilai hasil lemparan ada di Message.Dice.Value.
+func (b *Bot) SendDice(chatID int64, emoji string) (*Message, error) {
+	return b.SendDiceWithOptions(chatID, emoji, SendOptions{})
+}
+
+// SendDiceWithOptions sama dengan SendDice dengan opsi tambahan, misalnya MessageThreadID
+func (b *Bot) SendDiceWithOptions(chatID int64, emoji string, opts SendOptions) (*Message, error) {
+	switch emoji {
+	case "":
+		emoji = DiceEmojiDice
+	case DiceEmojiDice, DiceEmojiDarts, DiceEmojiBasketball, DiceEmojiFootball, DiceEmojiBowling, DiceEmojiSlotMachine:
+	default:
+		return nil, fmt.Errorf("telegram: sendDice: unsupported emoji %q", emoji)
+	}
+
+	req := sendDiceRequest{ChatID: chatID, Emoji: emoji, SendOptions: opts}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "sendDice", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
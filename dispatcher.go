@@ -0,0 +1,204 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// MessageHandler menangani satu pesan masuk
+type MessageHandler func(*Bot, *Message)
+
+// HandlerFunc menangani satu update
+type HandlerFunc func(*Bot, Update)
+
+// Middleware membungkus HandlerFunc; middleware dapat menghentikan rantai dengan tidak memanggil next
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Dispatcher meneruskan update ke handler command atau route HandleFilter yang terdaftar.
+// Daftarkan semua handler sebelum Handle dipanggil.
+type Dispatcher struct {
+	bot        *Bot
+	commands   map[string]MessageHandler
+	fallback   MessageHandler
+	routes     []route
+	middleware []Middleware
+
+	mu     sync.Mutex
+	cancel context.CancelFunc // membatalkan polling Run yang sedang berjalan
+	done   chan struct{}      // ditutup ketika Run selesai
+}
+
+// NewDispatcher membuat Dispatcher untuk bot
+func NewDispatcher(bot *Bot) *Dispatcher {
+	return &Dispatcher{
+		bot:      bot,
+		commands: make(map[string]MessageHandler),
+	}
+}
+
+// Command mendaftarkan handler untuk command, misalnya "start" atau "/start"
+func (d *Dispatcher) Command(name string, handler func(*Bot, *Message)) {
+	d.commands[normalizeCommand(name)] = handler
+}
+
+// Fallback mendaftarkan handler untuk pesan yang tidak cocok dengan command mana pun
+func (d *Dispatcher) Fallback(handler func(*Bot, *Message)) {
+	d.fallback = handler
+}
+
+// Use menambahkan middleware. Middleware dijalankan sesuai urutan pendaftaran, yang pertama
+// menjadi lapisan terluar:
+//
+//	d.Use(telegrambot.RecoverMiddleware)
+//	d.Use(func(next telegrambot.HandlerFunc) telegrambot.HandlerFunc {
+//		return func(bot *telegrambot.Bot, u telegrambot.Update) {
+//			if u.Message.From.ID != adminID {
+//				return // blokir pengguna selain admin
+//			}
+//			next(bot, u)
+//		}
+//	})
+func (d *Dispatcher) Use(middleware func(next HandlerFunc) HandlerFunc) {
+	d.middleware = append(d.middleware, middleware)
+}
+
+// Handle memproses satu update melalui middleware lalu meneruskannya ke handler yang cocok
+func (d *Dispatcher) Handle(update Update) {
+	h := HandlerFunc(d.dispatch)
+	for i := len(d.middleware) - 1; i >= 0; i-- {
+		h = d.middleware[i](h)
+	}
+	h(d.bot, update)
+}
+
+// Run menjalankan long polling dan meneruskan setiap update ke Handle sampai ctx dibatalkan
+// atau Stop dipanggil. Panic di handler dipulihkan seperti pada ProcessUpdates. Hasilnya nil
+// jika dihentikan dengan Stop. opts diteruskan ke ProcessUpdates, misalnya WithWorkers.
+func (d *Dispatcher) Run(ctx context.Context, timeout int, opts ...ProcessOption) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	d.mu.Lock()
+	if d.cancel != nil {
+		d.mu.Unlock()
+		return errors.New("telegram: dispatcher is already running")
+	}
+	d.cancel = cancel
+	d.done = make(chan struct{})
+	d.mu.Unlock()
+
+	err := d.bot.ProcessUpdates(runCtx, timeout, d.Handle, opts...)
+
+	d.mu.Lock()
+	d.cancel = nil
+	close(d.done)
+	d.mu.Unlock()
+
+	if errors.Is(err, context.Canceled) && ctx.Err() == nil {
+		return nil
+	}
+	return err
+}
+
+// Stop menghentikan pengambilan update baru lalu menunggu handler yang sedang berjalan dan
+// update yang sudah diterima selesai diproses, dibatasi oleh ctx. Cocok dipanggil saat SIGTERM:
+//
+//	go d.Run(context.Background(), 30)
+//	<-sigterm
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	if err := d.Stop(ctx); err != nil {
+//		log.Printf("shutdown: %v", err)
+//	}
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	d.mu.Lock()
+	cancel, done := d.cancel, d.done
+	d.mu.Unlock()
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dispatch mencari handler: command diambil dari entity bot_command di awal teks,
+// akhiran @botusername dibuang, lalu handler yang cocok dipanggil. Update yang bukan command
+// terdaftar dicocokkan dengan route HandleFilter, lalu pesan yang tersisa ke fallback.
+func (d *Dispatcher) dispatch(_ *Bot, update Update) {
+	msg := &update.Message
+	if msg.MessageID != 0 {
+		if name, mention, ok := parseCommand(msg); ok {
+			if !d.addressedToUs(mention) {
+				// command untuk bot lain di grup
+				return
+			}
+			if handler, found := d.commands[name]; found {
+				handler(d.bot, msg)
+				return
+			}
+		}
+	}
+
+	for _, r := range d.routes {
+		if r.filter(update) {
+			r.handler(d.bot, update)
+			return
+		}
+	}
+
+	if msg.MessageID != 0 && d.fallback != nil {
+		d.fallback(d.bot, msg)
+	}
+}
+
+// addressedToUs melaporkan apakah command dengan @mention ditujukan ke bot ini.
+// Jika identitas bot belum diketahui (GetMe belum dipanggil), semua mention diterima.
+func (d *Dispatcher) addressedToUs(mention string) bool {
+	if mention == "" || d.bot == nil {
+		return true
+	}
+	self := d.bot.Self()
+	return self == nil || strings.EqualFold(self.Username, mention)
+}
+
+// parseCommand mengambil nama command dan @mention dari pesan yang diawali entity bot_command
+func parseCommand(m *Message) (name, mention string, ok bool) {
+	for _, e := range m.Entities {
+		if e.Type != "bot_command" || e.Offset != 0 {
+			continue
+		}
+		cmd := strings.TrimPrefix(m.EntityText(e), "/")
+		if i := strings.Index(cmd, "@"); i >= 0 {
+			cmd, mention = cmd[:i], cmd[i+1:]
+		}
+		return strings.ToLower(cmd), mention, true
+	}
+	return "", "", false
+}
+
+// normalizeCommand membuang awalan "/" dan menyeragamkan huruf kecil
+func normalizeCommand(name string) string {
+	return strings.ToLower(strings.TrimPrefix(name, "/"))
+}
+
+// RecoverMiddleware menangkap panic di handler dan mencatatnya lewat Logger bot sehingga loop
+// polling tetap berjalan
+func RecoverMiddleware(next HandlerFunc) HandlerFunc {
+	return func(bot *Bot, update Update) {
+		defer func() {
+			if r := recover(); r != nil {
+				bot.errorf("telegram: panic handling update %d: %v\n%s", update.UpdateID, r, debug.Stack())
+			}
+		}()
+		next(bot, update)
+	}
+}
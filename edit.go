@@ -0,0 +1,181 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// EditOptions berisi parameter opsional untuk method edit pesan. Field yang bernilai nol tidak dikirim.
+type EditOptions struct {
+	ParseMode             string                `json:"parse_mode,omitempty"`
+	DisableWebPagePreview bool                  `json:"disable_web_page_preview,omitempty"`
+	LinkPreviewOptions    *LinkPreviewOptions   `json:"link_preview_options,omitempty"` // menggantikan DisableWebPagePreview
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// normalize memvalidasi opsi dan membuang DisableWebPagePreview jika LinkPreviewOptions diisi
+func (o EditOptions) normalize() (EditOptions, error) {
+	if err := validateParseMode(o.ParseMode); err != nil {
+		return o, err
+	}
+	if o.LinkPreviewOptions != nil {
+		o.DisableWebPagePreview = false
+	}
+	return o, nil
+}
+
+// messageRef menunjuk pesan yang diedit: chat_id dan message_id, atau inline_message_id
+type messageRef struct {
+	ChatID          int64  `json:"chat_id,omitempty"`
+	MessageID       int    `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// chatMessageParams membuat form values untuk pesan yang diidentifikasi dengan chat_id dan message_id
+func chatMessageParams(chatID int64, messageID int) url.Values {
+	data := url.Values{}
+	data.Set("chat_id", strconv.FormatInt(chatID, 10))
+	data.Set("message_id", strconv.Itoa(messageID))
+	return data
+}
+
+// editMessageTextRequest adalah body JSON editMessageText
+type editMessageTextRequest struct {
+	messageRef
+	Text string `json:"text"`
+	EditOptions
+}
+
+// EditMessageText mengubah teks pesan yang sudah terkirim dan mengembalikan pesan hasil edit
+func (b *Bot) EditMessageText(chatID int64, messageID int, text string, opts EditOptions) (*Message, error) {
+	opts, err := opts.normalize()
+	if err != nil {
+		return nil, err
+	}
+	req := editMessageTextRequest{messageRef: messageRef{ChatID: chatID, MessageID: messageID}, Text: text, EditOptions: opts}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "editMessageText", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// EditInlineMessageText mengubah teks pesan inline (dikirim lewat inline mode)
+func (b *Bot) EditInlineMessageText(inlineMessageID, text string, opts EditOptions) error {
+	opts, err := opts.normalize()
+	if err != nil {
+		return err
+	}
+	req := editMessageTextRequest{messageRef: messageRef{InlineMessageID: inlineMessageID}, Text: text, EditOptions: opts}
+	return b.doJSONRequest(context.Background(), "editMessageText", req, nil)
+}
+
+// editMessageMedia mengirim editMessageMedia. File baru di-upload sebagai multipart dengan
+// attach://, sedangkan file_id/URL dikirim sebagai form biasa.
+func (b *Bot) editMessageMedia(ref messageRef, media InputMedia, markup *InlineKeyboardMarkup, out interface{}) error {
+	if err := media.validate(); err != nil {
+		return err
+	}
+	payload, files := encodeInputMedia(media, "file0", nil)
+
+	data := url.Values{}
+	if ref.InlineMessageID != "" {
+		data.Set("inline_message_id", ref.InlineMessageID)
+	} else {
+		data = chatMessageParams(ref.ChatID, ref.MessageID)
+	}
+	if err := setJSON(data, "media", payload); err != nil {
+		return err
+	}
+	if markup != nil {
+		if err := setJSON(data, "reply_markup", markup); err != nil {
+			return err
+		}
+	}
+	return b.doUpload(context.Background(), "editMessageMedia", data, files, out)
+}
+
+// EditMessageMedia mengganti foto/video pesan dengan media baru dan mengembalikan pesan hasil edit.
+// markup nil menghapus inline keyboard pesan.
+func (b *Bot) EditMessageMedia(chatID int64, messageID int, media InputMedia, markup *InlineKeyboardMarkup) (*Message, error) {
+	var msg Message
+	if err := b.editMessageMedia(messageRef{ChatID: chatID, MessageID: messageID}, media, markup, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// EditInlineMessageMedia mengganti media pesan inline. Pesan inline tidak menerima upload baru,
+// jadi media harus berupa file_id atau URL.
+func (b *Bot) EditInlineMessageMedia(inlineMessageID string, media InputMedia, markup *InlineKeyboardMarkup) error {
+	if media.inputFile().needsUpload() {
+		return fmt.Errorf("telegram: editMessageMedia: inline messages accept only file_id or URL media")
+	}
+	return b.editMessageMedia(messageRef{InlineMessageID: inlineMessageID}, media, markup, nil)
+}
+
+// editMessageCaptionRequest adalah body JSON editMessageCaption; opsi link preview tidak berlaku untuk caption
+type editMessageCaptionRequest struct {
+	messageRef
+	Caption     string                `json:"caption"`
+	ParseMode   string                `json:"parse_mode,omitempty"`
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// newEditMessageCaptionRequest memvalidasi opts lalu membuat body editMessageCaption
+func newEditMessageCaptionRequest(ref messageRef, caption string, opts EditOptions) (editMessageCaptionRequest, error) {
+	if err := validateParseMode(opts.ParseMode); err != nil {
+		return editMessageCaptionRequest{}, err
+	}
+	return editMessageCaptionRequest{messageRef: ref, Caption: caption, ParseMode: opts.ParseMode, ReplyMarkup: opts.ReplyMarkup}, nil
+}
+
+// EditMessageCaption mengubah caption pesan media; caption kosong menghapus caption
+func (b *Bot) EditMessageCaption(chatID int64, messageID int, caption string, opts EditOptions) (*Message, error) {
+	req, err := newEditMessageCaptionRequest(messageRef{ChatID: chatID, MessageID: messageID}, caption, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "editMessageCaption", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// EditInlineMessageCaption mengubah caption pesan inline
+func (b *Bot) EditInlineMessageCaption(inlineMessageID, caption string, opts EditOptions) error {
+	req, err := newEditMessageCaptionRequest(messageRef{InlineMessageID: inlineMessageID}, caption, opts)
+	if err != nil {
+		return err
+	}
+	return b.doJSONRequest(context.Background(), "editMessageCaption", req, nil)
+}
+
+// editMessageReplyMarkupRequest adalah body JSON editMessageReplyMarkup. reply_markup yang
+// tidak dikirim membuat Telegram menghapus keyboard.
+type editMessageReplyMarkupRequest struct {
+	messageRef
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageReplyMarkup mengganti inline keyboard pesan; markup nil menghapus keyboard
+func (b *Bot) EditMessageReplyMarkup(chatID int64, messageID int, markup *InlineKeyboardMarkup) (*Message, error) {
+	req := editMessageReplyMarkupRequest{messageRef: messageRef{ChatID: chatID, MessageID: messageID}, ReplyMarkup: markup}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "editMessageReplyMarkup", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// EditInlineMessageReplyMarkup mengganti inline keyboard pesan inline; markup nil menghapus keyboard
+func (b *Bot) EditInlineMessageReplyMarkup(inlineMessageID string, markup *InlineKeyboardMarkup) error {
+	req := editMessageReplyMarkupRequest{messageRef: messageRef{InlineMessageID: inlineMessageID}, ReplyMarkup: markup}
+	return b.doJSONRequest(context.Background(), "editMessageReplyMarkup", req, nil)
+}
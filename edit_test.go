@@ -0,0 +1,116 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEditMessageMedia(t *testing.T) {
+	tests := []struct {
+		name      string
+		media     InputMedia
+		wantMedia string
+		wantFile  string // isi bagian file0; kosong berarti tidak ada upload
+	}{
+		{
+			name:      "upload",
+			media:     InputMediaPhoto{Media: FileReader("chart.png", strings.NewReader("png-bytes")), Caption: "v2"},
+			wantMedia: "attach://file0",
+			wantFile:  "png-bytes",
+		},
+		{
+			name:      "file_id",
+			media:     InputMediaPhoto{Media: FileID("AgAD")},
+			wantMedia: "AgAD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var media map[string]interface{}
+			var file string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseMultipartForm(1 << 20); err != nil && err != http.ErrNotMultipart {
+					t.Errorf("ParseMultipartForm: %v", err)
+				}
+				if r.FormValue("message_id") != "7" {
+					t.Errorf("message_id = %q, want 7", r.FormValue("message_id"))
+				}
+				if err := json.Unmarshal([]byte(r.FormValue("media")), &media); err != nil {
+					t.Errorf("media = %q: %v", r.FormValue("media"), err)
+				}
+				if f, _, err := r.FormFile("file0"); err == nil {
+					b, _ := ioutil.ReadAll(f)
+					file = string(b)
+				}
+				w.Write([]byte(`{"ok":true,"result":{"message_id":7}}`))
+			}))
+			defer srv.Close()
+
+			bot := NewBot("123:abc")
+			bot.BaseURL = srv.URL
+			msg, err := bot.EditMessageMedia(1, 7, tt.media, nil)
+			if err != nil {
+				t.Fatalf("EditMessageMedia: %v", err)
+			}
+			if msg.MessageID != 7 {
+				t.Errorf("MessageID = %d, want 7", msg.MessageID)
+			}
+			if media["type"] != "photo" || media["media"] != tt.wantMedia {
+				t.Errorf("media = %v, want photo %s", media, tt.wantMedia)
+			}
+			if file != tt.wantFile {
+				t.Errorf("file0 = %q, want %q", file, tt.wantFile)
+			}
+		})
+	}
+}
+
+func TestEditInlineMessageMediaRejectsUpload(t *testing.T) {
+	bot := NewBot("123:abc")
+	media := InputMediaPhoto{Media: FileReader("a.png", strings.NewReader("x"))}
+	if err := bot.EditInlineMessageMedia("inline-1", media, nil); err == nil {
+		t.Error("EditInlineMessageMedia with upload should fail")
+	}
+}
+
+func TestEditMessageReplyMarkupAndCaption(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(b *Bot) error
+		want map[string]interface{}
+	}{
+		{
+			name: "remove keyboard",
+			call: func(b *Bot) error {
+				_, err := b.EditMessageReplyMarkup(1, 7, nil)
+				return err
+			},
+			want: map[string]interface{}{"chat_id": float64(1), "message_id": float64(7)},
+		},
+		{
+			name: "inline caption",
+			call: func(b *Bot) error {
+				return b.EditInlineMessageCaption("inline-1", "<b>baru</b>", EditOptions{ParseMode: ParseModeHTML})
+			},
+			want: map[string]interface{}{"inline_message_id": "inline-1", "caption": "<b>baru</b>", "parse_mode": "HTML"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, _, body := captureServer(t, `{"message_id":7}`)
+			if err := tt.call(bot); err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			if !reflect.DeepEqual(*body, tt.want) {
+				t.Errorf("body = %v, want %v", *body, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package telegrambot
+
+import "unicode/utf16"
+
+// Jenis entity pesan
+const (
+	EntityMention     = "mention"
+	EntityHashtag     = "hashtag"
+	EntityCashtag     = "cashtag"
+	EntityBotCommand  = "bot_command"
+	EntityURL         = "url"
+	EntityEmail       = "email"
+	EntityPhoneNumber = "phone_number"
+	EntityBold        = "bold"
+	EntityItalic      = "italic"
+	EntityUnderline   = "underline"
+	EntityStrike      = "strikethrough"
+	EntitySpoiler     = "spoiler"
+	EntityCode        = "code"
+	EntityPre         = "pre"
+	EntityTextLink    = "text_link"
+	EntityTextMention = "text_mention"
+	EntityCustomEmoji = "custom_emoji"
+)
+
+// EntityText mengembalikan potongan Text yang ditandai entity. Offset dan Length dari
+// Telegram dihitung dalam unit UTF-16, sehingga teks dikonversi dulu sebelum dipotong.
+func (m *Message) EntityText(e Entity) string {
+	return utf16Substring(m.Text, e.Offset, e.Length)
+}
+
+// CaptionEntityText sama dengan EntityText tetapi untuk entity dari CaptionEntities
+func (m *Message) CaptionEntityText(e Entity) string {
+	return utf16Substring(m.Caption, e.Offset, e.Length)
+}
+
+// Commands mengembalikan semua bot command di pesan, misalnya "/start@MyBot"
+func (m *Message) Commands() []string {
+	return m.entityTexts(EntityBotCommand)
+}
+
+// Mentions mengembalikan semua @username di pesan
+func (m *Message) Mentions() []string {
+	return m.entityTexts(EntityMention)
+}
+
+// URLs mengembalikan semua URL di pesan, termasuk URL tersembunyi dari entity text_link
+func (m *Message) URLs() []string {
+	var urls []string
+	for _, e := range m.Entities {
+		switch e.Type {
+		case EntityURL:
+			urls = append(urls, m.EntityText(e))
+		case EntityTextLink:
+			urls = append(urls, e.URL)
+		}
+	}
+	return urls
+}
+
+// entityTexts mengembalikan teks dari semua entity dengan jenis tertentu
+func (m *Message) entityTexts(entityType string) []string {
+	var texts []string
+	for _, e := range m.Entities {
+		if e.Type == entityType {
+			texts = append(texts, m.EntityText(e))
+		}
+	}
+	return texts
+}
+
+// utf16Substring memotong s dengan offset dan length dalam unit UTF-16.
+// Batas yang melewati panjang teks dipangkas.
+func utf16Substring(s string, offset, length int) string {
+	units := utf16.Encode([]rune(s))
+	if offset < 0 || length <= 0 || offset >= len(units) {
+		return ""
+	}
+	end := offset + length
+	if end > len(units) {
+		end = len(units)
+	}
+	return string(utf16.Decode(units[offset:end]))
+}
@@ -0,0 +1,66 @@
+package telegrambot
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEntityText(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		entity Entity
+		want   string
+	}{
+		{"ascii", "/start now", Entity{Type: EntityBotCommand, Offset: 0, Length: 6}, "/start"},
+		{"surrogate pair before entity", "👍 /start", Entity{Type: EntityBotCommand, Offset: 3, Length: 6}, "/start"},
+		{"entity is emoji", "hi 👍!", Entity{Type: EntityBold, Offset: 3, Length: 2}, "👍"},
+		{"cyrillic offset", "Привет @ivan", Entity{Type: EntityMention, Offset: 7, Length: 5}, "@ivan"},
+		{"cyrillic entity", "скажи привет", Entity{Type: EntityBold, Offset: 6, Length: 6}, "привет"},
+		{"emoji and cyrillic", "😀😀 Дом https://x.io", Entity{Type: EntityURL, Offset: 9, Length: 12}, "https://x.io"},
+		{"length past end", "abc", Entity{Offset: 1, Length: 10}, "bc"},
+		{"offset past end", "abc", Entity{Offset: 5, Length: 1}, ""},
+		{"negative offset", "abc", Entity{Offset: -1, Length: 1}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Message{Text: tt.text}
+			if got := m.EntityText(tt.entity); got != tt.want {
+				t.Errorf("EntityText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageEntityHelpers(t *testing.T) {
+	m := &Message{
+		Text: "👋 /start@MyBot привет @ivan https://go.dev ссылка",
+		Entities: []Entity{
+			{Type: EntityBotCommand, Offset: 3, Length: 12},
+			{Type: EntityMention, Offset: 23, Length: 5},
+			{Type: EntityURL, Offset: 29, Length: 14},
+			{Type: EntityTextLink, Offset: 44, Length: 6, URL: "https://example.com"},
+		},
+	}
+
+	if got, want := m.Commands(), []string{"/start@MyBot"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Commands() = %q, want %q", got, want)
+	}
+	if got, want := m.Mentions(), []string{"@ivan"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Mentions() = %q, want %q", got, want)
+	}
+	if got, want := m.URLs(), []string{"https://go.dev", "https://example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("URLs() = %q, want %q", got, want)
+	}
+}
+
+func TestCaptionEntityText(t *testing.T) {
+	// "🎉" adalah surrogate pair sehingga offset "Промо" adalah 3, bukan 2
+	msg := &Message{
+		Caption:         "🎉 Промо code",
+		CaptionEntities: []Entity{{Type: EntityBold, Offset: 3, Length: 5}},
+	}
+	if got := msg.CaptionEntityText(msg.CaptionEntities[0]); got != "Промо" {
+		t.Errorf("CaptionEntityText = %q, want %q", got, "Промо")
+	}
+}
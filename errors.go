@@ -0,0 +1,126 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// APIError adalah error yang dikembalikan API Telegram (ok=false).
+// Gunakan errors.As untuk memeriksa Code, Description, dan Parameters.
+type APIError struct {
+	Code        int                `json:"error_code"`
+	Description string             `json:"description"`
+	Parameters  ResponseParameters `json:"parameters"`
+}
+
+// Error mengembalikan pesan error yang mudah dibaca
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("telegram: %d %s", e.Code, e.Description)
+	if e.Parameters.RetryAfter > 0 {
+		msg += fmt.Sprintf(" (retry after %ds)", e.Parameters.RetryAfter)
+	}
+	if e.Parameters.MigrateToChatID != 0 {
+		msg += fmt.Sprintf(" (migrate to chat %d)", e.Parameters.MigrateToChatID)
+	}
+	return msg
+}
+
+// ErrWebhookActive dicocokkan lewat errors.Is oleh error 409 dari getUpdates ketika webhook
+// masih terpasang. Hapus webhook dengan DeleteWebhook, atau pakai StartPolling.
+var ErrWebhookActive = errors.New("telegram: webhook is active, delete it before polling")
+
+// Is membuat errors.Is(err, ErrWebhookActive) bernilai true untuk 409 karena webhook aktif
+func (e *APIError) Is(target error) bool {
+	return target == ErrWebhookActive && e.Code == http.StatusConflict &&
+		strings.Contains(strings.ToLower(e.Description), "webhook")
+}
+
+// parseAPIError membaca body response yang gagal menjadi *APIError
+func parseAPIError(resp *http.Response) error {
+	bodyBytes, _ := ioutil.ReadAll(resp.Body)
+
+	apiErr := &APIError{}
+	if err := json.Unmarshal(bodyBytes, apiErr); err != nil || apiErr.Description == "" {
+		apiErr.Description = redactToken(strings.TrimSpace(string(bodyBytes)))
+		if apiErr.Description == "" {
+			apiErr.Description = http.StatusText(resp.StatusCode)
+		}
+	}
+	if apiErr.Code == 0 {
+		apiErr.Code = resp.StatusCode
+	}
+	return apiErr
+}
+
+// tokenPattern cocok dengan bagian "bot<token>" pada URL Bot API
+var tokenPattern = regexp.MustCompile(`bot[0-9]+:[A-Za-z0-9_-]+`)
+
+// redactToken mengganti setiap "bot<token>" di s dengan "bot<redacted>"
+func redactToken(s string) string {
+	return tokenPattern.ReplaceAllString(s, "bot<redacted>")
+}
+
+// redactError menyamarkan token pada URL di dalam *url.Error (error dari http.Client)
+// tanpa mengubah rantai error, sehingga errors.Is/As tetap bekerja
+func redactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redactToken(urlErr.URL)
+	}
+	return err
+}
+
+// asAPIError membuka err menjadi *APIError jika memungkinkan
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsBlocked melaporkan apakah err berarti bot diblokir oleh pengguna (403)
+func IsBlocked(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.Code == http.StatusForbidden &&
+		strings.Contains(strings.ToLower(apiErr.Description), "bot was blocked by the user")
+}
+
+// IsUnauthorized melaporkan apakah err berarti token bot tidak valid atau sudah dicabut (401)
+func IsUnauthorized(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.Code == http.StatusUnauthorized
+}
+
+// IsRateLimited melaporkan apakah err adalah 429 dan berapa lama harus menunggu
+func IsRateLimited(err error) (retryAfter time.Duration, ok bool) {
+	apiErr, ok := asAPIError(err)
+	if !ok || apiErr.Code != http.StatusTooManyRequests {
+		return 0, false
+	}
+	return time.Duration(apiErr.Parameters.RetryAfter) * time.Second, true
+}
+
+// IsChatNotFound melaporkan apakah err berarti chat tidak ditemukan (400 "chat not found")
+func IsChatNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.Code == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Description), "chat not found")
+}
+
+// IsChatMigrated melaporkan apakah grup sudah di-upgrade menjadi supergroup dan
+// mengembalikan chat id barunya
+func IsChatMigrated(err error) (newID int64, ok bool) {
+	apiErr, ok := asAPIError(err)
+	if !ok || apiErr.Parameters.MigrateToChatID == 0 {
+		return 0, false
+	}
+	return apiErr.Parameters.MigrateToChatID, true
+}
@@ -0,0 +1,44 @@
+package telegrambot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestErrorsDoNotContainToken(t *testing.T) {
+	const token = "123456:AAHsecret-token_value"
+	bot := NewBot(token)
+	// port 1 di loopback tidak pernah menerima koneksi
+	bot.BaseURL = "http://127.0.0.1:1"
+
+	_, err := bot.GetMe()
+	if err == nil {
+		t.Fatal("GetMe to an unreachable host succeeded")
+	}
+	if strings.Contains(err.Error(), token) || strings.Contains(err.Error(), "AAHsecret") {
+		t.Fatalf("error leaks token: %v", err)
+	}
+	if !strings.Contains(err.Error(), "bot<redacted>") {
+		t.Errorf("error = %v, want redacted URL", err)
+	}
+
+	err = bot.DownloadFile(&File{FilePath: "documents/file.pdf"}, &strings.Builder{})
+	if err == nil || strings.Contains(err.Error(), "AAHsecret") {
+		t.Fatalf("DownloadFile error leaks token: %v", err)
+	}
+}
+
+func TestRedactToken(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"https://api.telegram.org/bot123:abc-DEF_9/getMe", "https://api.telegram.org/bot<redacted>/getMe"},
+		{"https://api.telegram.org/file/bot123:abc/photos/a.jpg", "https://api.telegram.org/file/bot<redacted>/photos/a.jpg"},
+		{"no token here", "no token here"},
+	}
+	for _, tt := range tests {
+		if got := redactToken(tt.in); got != tt.want {
+			t.Errorf("redactToken(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
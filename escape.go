@@ -0,0 +1,30 @@
+package telegrambot
+
+import "strings"
+
+var (
+	markdownV2Escaper = strings.NewReplacer(
+		`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`,
+		"~", `\~`, "`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`,
+		"|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+	)
+	markdownV2CodeEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`")
+	htmlEscaper           = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// EscapeMarkdownV2 meng-escape semua karakter khusus MarkdownV2 sehingga teks dari pengguna
+// aman disisipkan ke pesan dengan ParseModeMarkdownV2
+func EscapeMarkdownV2(s string) string {
+	return markdownV2Escaper.Replace(s)
+}
+
+// EscapeMarkdownV2Code meng-escape teks di dalam blok `code` atau ```pre``` MarkdownV2,
+// yang hanya mewajibkan escaping ` dan \
+func EscapeMarkdownV2Code(s string) string {
+	return markdownV2CodeEscaper.Replace(s)
+}
+
+// EscapeHTML meng-escape &, <, >, dan " sehingga teks aman dipakai dengan ParseModeHTML
+func EscapeHTML(s string) string {
+	return htmlEscaper.Replace(s)
+}
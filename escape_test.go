@@ -0,0 +1,57 @@
+package telegrambot
+
+import "testing"
+
+func TestEscapeMarkdownV2(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"plain", "hello world", "hello world"},
+		{"every special character", "_*[]()~`>#+-=|{}.!", `\_\*\[\]\(\)\~` + "\\`" + `\>\#\+\-\=\|\{\}\.\!`},
+		{"backslash", `a\b`, `a\\b`},
+		{"url", "https://example.com/a_b?x=1&y=(2)", `https://example\.com/a\_b?x\=1&y\=\(2\)`},
+		{"inline code", "use `go test` now", "use \\`go test\\` now"},
+		{"non ascii untouched", "Привет 👋", "Привет 👋"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeMarkdownV2(tt.in); got != tt.want {
+				t.Errorf("EscapeMarkdownV2(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeMarkdownV2Code(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"fmt.Println(x_y*2)", "fmt.Println(x_y*2)"},
+		{"a`b", "a\\`b"},
+		{`C:\path`, `C:\\path`},
+	}
+	for _, tt := range tests {
+		if got := EscapeMarkdownV2Code(tt.in); got != tt.want {
+			t.Errorf("EscapeMarkdownV2Code(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeHTML(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"plain", "hello", "hello"},
+		{"every special character", `&<>"`, "&amp;&lt;&gt;&quot;"},
+		{"url", `https://example.com/?a=1&b="2"`, "https://example.com/?a=1&amp;b=&quot;2&quot;"},
+		{"code", "<code>x < y</code>", "&lt;code&gt;x &lt; y&lt;/code&gt;"},
+		{"markdown characters untouched", "*_[]", "*_[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeHTML(tt.in); got != tt.want {
+				t.Errorf("EscapeHTML(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
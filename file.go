@@ -0,0 +1,166 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// GetFile mengambil informasi file (termasuk FilePath) untuk diunduh
+func (b *Bot) GetFile(fileID string) (*File, error) {
+	data := url.Values{}
+	data.Set("file_id", fileID)
+
+	var file File
+	if err := b.doRequest(context.Background(), "getFile", data, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// fileURL membangun URL unduhan untuk file_path dari getFile
+func (b *Bot) fileURL(filePath string) string {
+	return fmt.Sprintf("%s/file/bot%s/%s", b.baseURL(), b.Token, filePath)
+}
+
+// ErrTruncatedDownload dikembalikan (terbungkus) jika unduhan berakhir sebelum semua byte diterima
+var ErrTruncatedDownload = errors.New("telegram: download truncated")
+
+// DownloadOptions berisi parameter opsional DownloadFileWithOptions
+type DownloadOptions struct {
+	// Offset melanjutkan unduhan mulai byte ini dengan header Range; w hanya menerima sisanya
+	Offset int64
+	// Progress dipanggil setiap kali data diterima. bytesRead termasuk Offset; total diambil
+	// dari File.FileSize atau Content-Length, dan bernilai -1 jika tidak diketahui.
+	Progress func(bytesRead, total int64)
+}
+
+// DownloadFile mengunduh isi file dan menuliskannya ke w
+func (b *Bot) DownloadFile(f *File, w io.Writer) error {
+	return b.DownloadFileWithOptions(context.Background(), f, w, DownloadOptions{})
+}
+
+// DownloadFileWithOptions mengunduh file dengan dukungan resume dan laporan progres.
+// Jumlah byte yang diterima dicocokkan dengan Content-Length dan File.FileSize; unduhan
+// yang terpotong menghasilkan ErrTruncatedDownload.
+func (b *Bot) DownloadFileWithOptions(ctx context.Context, f *File, w io.Writer, opts DownloadOptions) error {
+	if f == nil || f.FilePath == "" {
+		return fmt.Errorf("telegram: download file: missing file path")
+	}
+	if opts.Offset < 0 || (f.FileSize > 0 && opts.Offset > f.FileSize) {
+		return fmt.Errorf("telegram: download file: invalid offset %d", opts.Offset)
+	}
+
+	if b.LocalMode && filepath.IsAbs(f.FilePath) {
+		return downloadLocal(f, w, opts)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.fileURL(f.FilePath), nil)
+	if err != nil {
+		return fmt.Errorf("telegram: download file: %w", redactError(err))
+	}
+	if opts.Offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", opts.Offset))
+	}
+	resp, err := b.client().Do(req)
+	if err != nil {
+		return fmt.Errorf("telegram: download file: %w", redactError(err))
+	}
+	defer resp.Body.Close()
+
+	start := opts.Offset
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// server mengabaikan Range: buang byte yang sudah dimiliki pemanggil
+		if start > 0 {
+			if _, err := io.CopyN(ioutil.Discard, resp.Body, start); err != nil {
+				return fmt.Errorf("telegram: download file: %w", ErrTruncatedDownload)
+			}
+			if resp.ContentLength >= 0 {
+				resp.ContentLength -= start
+			}
+		}
+	default:
+		return parseAPIError(resp)
+	}
+
+	total := int64(-1)
+	if f.FileSize > 0 {
+		total = f.FileSize
+	} else if resp.ContentLength >= 0 {
+		total = start + resp.ContentLength
+	}
+
+	pw := &progressWriter{w: w, read: start, total: total, progress: opts.Progress}
+	n, err := io.Copy(pw, resp.Body)
+	if err != nil {
+		return fmt.Errorf("telegram: download file: %w", err)
+	}
+	if resp.ContentLength >= 0 && n != resp.ContentLength {
+		return fmt.Errorf("%w: got %d of %d bytes", ErrTruncatedDownload, n, resp.ContentLength)
+	}
+	if total >= 0 && start+n != total {
+		return fmt.Errorf("%w: got %d of %d bytes", ErrTruncatedDownload, start+n, total)
+	}
+	return nil
+}
+
+// downloadLocal membaca file yang disimpan Local Bot API Server langsung dari disk
+func downloadLocal(f *File, w io.Writer, opts DownloadOptions) error {
+	file, err := os.Open(f.FilePath)
+	if err != nil {
+		return fmt.Errorf("telegram: download file: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("telegram: download file: %w", err)
+	}
+	if _, err := file.Seek(opts.Offset, io.SeekStart); err != nil {
+		return fmt.Errorf("telegram: download file: %w", err)
+	}
+
+	pw := &progressWriter{w: w, read: opts.Offset, total: info.Size(), progress: opts.Progress}
+	n, err := io.Copy(pw, file)
+	if err != nil {
+		return fmt.Errorf("telegram: download file: %w", err)
+	}
+	if opts.Offset+n != info.Size() {
+		return fmt.Errorf("%w: got %d of %d bytes", ErrTruncatedDownload, opts.Offset+n, info.Size())
+	}
+	return nil
+}
+
+// progressWriter meneruskan tulisan ke w sambil melaporkan jumlah byte ke progress
+type progressWriter struct {
+	w        io.Writer
+	read     int64
+	total    int64
+	progress func(bytesRead, total int64)
+}
+
+func (p *progressWriter) Write(buf []byte) (int, error) {
+	n, err := p.w.Write(buf)
+	p.read += int64(n)
+	if p.progress != nil {
+		p.progress(p.read, p.total)
+	}
+	return n, err
+}
+
+// DownloadFileByID menggabungkan GetFile dan DownloadFile
+func (b *Bot) DownloadFileByID(fileID string, w io.Writer) error {
+	f, err := b.GetFile(fileID)
+	if err != nil {
+		return err
+	}
+	return b.DownloadFile(f, w)
+}
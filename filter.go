@@ -0,0 +1,135 @@
+package telegrambot
+
+import "regexp"
+
+// Filter memutuskan apakah sebuah update ditangani oleh handler yang didaftarkan dengan HandleFilter
+type Filter func(Update) bool
+
+// route memasangkan filter dengan handler-nya
+type route struct {
+	filter  Filter
+	handler HandlerFunc
+}
+
+// HandleFilter mendaftarkan handler untuk update yang lolos filter. Route dicoba sesuai urutan
+// pendaftaran setelah handler command, dan hanya route pertama yang cocok yang dijalankan:
+//
+//	d.HandleFilter(telegrambot.And(
+//		telegrambot.FilterChatType("private"),
+//		telegrambot.FilterTextRegex(regexp.MustCompile(`^\d{6}$`)),
+//	), verifyOTP)
+func (d *Dispatcher) HandleFilter(filter Filter, handler HandlerFunc) {
+	d.routes = append(d.routes, route{filter: filter, handler: handler})
+}
+
+// updateMessage mengembalikan pesan update, termasuk pesan milik callback query
+func updateMessage(u Update) *Message {
+	if msg := u.EffectiveMessage(); msg != nil {
+		return msg
+	}
+	if u.CallbackQuery != nil {
+		return u.CallbackQuery.Message
+	}
+	return nil
+}
+
+// updateSender mengembalikan pengirim update: pengirim pesan, penekan tombol, atau pengirim inline query
+func updateSender(u Update) *User {
+	switch {
+	case u.CallbackQuery != nil:
+		return &u.CallbackQuery.From
+	case u.InlineQuery != nil:
+		return &u.InlineQuery.From
+	}
+	if msg := u.EffectiveMessage(); msg != nil && msg.From.ID != 0 {
+		return &msg.From
+	}
+	return nil
+}
+
+// FilterTextRegex cocok jika teks pesan (bukan caption) cocok dengan re
+func FilterTextRegex(re *regexp.Regexp) Filter {
+	return func(u Update) bool {
+		msg := u.EffectiveMessage()
+		return msg != nil && re.MatchString(msg.Text)
+	}
+}
+
+// FilterChatType cocok jika update berasal dari chat dengan salah satu jenis types,
+// misalnya "private", "group", "supergroup" atau "channel"
+func FilterChatType(types ...string) Filter {
+	return func(u Update) bool {
+		msg := updateMessage(u)
+		if msg == nil {
+			return false
+		}
+		for _, t := range types {
+			if msg.Chat.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// FilterReply cocok jika pesan adalah balasan untuk pesan lain
+func FilterReply() Filter {
+	return func(u Update) bool {
+		msg := u.EffectiveMessage()
+		return msg != nil && msg.ReplyToMessage != nil
+	}
+}
+
+// FilterFromUser cocok jika update dikirim oleh salah satu user ids
+func FilterFromUser(ids ...int) Filter {
+	return func(u Update) bool {
+		from := updateSender(u)
+		if from == nil {
+			return false
+		}
+		for _, id := range ids {
+			if from.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// FilterCallbackData cocok untuk callback query yang datanya cocok dengan re
+func FilterCallbackData(re *regexp.Regexp) Filter {
+	return func(u Update) bool {
+		return u.CallbackQuery != nil && re.MatchString(u.CallbackQuery.Data)
+	}
+}
+
+// And cocok jika semua filter cocok; filter dievaluasi berurutan dan berhenti di yang pertama gagal
+func And(filters ...Filter) Filter {
+	return func(u Update) bool {
+		for _, f := range filters {
+			if !f(u) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or cocok jika salah satu filter cocok
+func Or(filters ...Filter) Filter {
+	return func(u Update) bool {
+		for _, f := range filters {
+			if f(u) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not membalik hasil filter
+func Not(filter Filter) Filter {
+	return func(u Update) bool {
+		return !filter(u)
+	}
+}
@@ -0,0 +1,70 @@
+package telegrambot
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestFilters(t *testing.T) {
+	private := Update{Message: Message{MessageID: 1, From: User{ID: 7}, Chat: Chat{ID: 7, Type: "private"}, Text: "123456"}}
+	groupReply := Update{Message: Message{
+		MessageID: 2, From: User{ID: 8}, Chat: Chat{ID: -1, Type: "supergroup"}, Text: "setuju",
+		ReplyToMessage: &Message{MessageID: 1},
+	}}
+	callback := Update{CallbackQuery: &CallbackQuery{From: User{ID: 7}, Data: "vote:2", Message: &Message{Chat: Chat{Type: "group"}}}}
+
+	otp := FilterTextRegex(regexp.MustCompile(`^\d{6}$`))
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []bool // private, groupReply, callback
+	}{
+		{"regex", otp, []bool{true, false, false}},
+		{"chat type", FilterChatType("group", "supergroup"), []bool{false, true, true}},
+		{"reply", FilterReply(), []bool{false, true, false}},
+		{"from user", FilterFromUser(7), []bool{true, false, true}},
+		{"callback data", FilterCallbackData(regexp.MustCompile(`^vote:`)), []bool{false, false, true}},
+		{"and", And(FilterChatType("private"), otp), []bool{true, false, false}},
+		{"or", Or(FilterReply(), FilterCallbackData(regexp.MustCompile(`.`))), []bool{false, true, true}},
+		{"not", Not(FilterFromUser(7)), []bool{false, true, false}},
+		{"empty and", And(), []bool{true, true, true}},
+		{"empty or", Or(), []bool{false, false, false}},
+	}
+	updates := []Update{private, groupReply, callback}
+	for _, tt := range tests {
+		for i, u := range updates {
+			if got := tt.filter(u); got != tt.want[i] {
+				t.Errorf("%s: update %d = %v, want %v", tt.name, i, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestDispatcherHandleFilterOrder(t *testing.T) {
+	d := NewDispatcher(NewBot("123:abc"))
+	var got []string
+	d.Command("start", func(*Bot, *Message) { got = append(got, "start") })
+	d.HandleFilter(FilterChatType("private"), func(*Bot, Update) { got = append(got, "private") })
+	d.HandleFilter(FilterTextRegex(regexp.MustCompile(`.`)), func(*Bot, Update) { got = append(got, "text") })
+	d.HandleFilter(FilterCallbackData(regexp.MustCompile(`.`)), func(*Bot, Update) { got = append(got, "callback") })
+	d.Fallback(func(*Bot, *Message) { got = append(got, "fallback") })
+
+	start := Update{Message: Message{MessageID: 1, Chat: Chat{Type: "private"}, Text: "/start",
+		Entities: []Entity{{Type: EntityBotCommand, Offset: 0, Length: 6}}}}
+	// command lebih dulu, lalu route pertama yang cocok
+	d.Handle(start)
+	d.Handle(Update{Message: Message{MessageID: 2, Chat: Chat{Type: "private"}, Text: "hai"}})
+	d.Handle(Update{Message: Message{MessageID: 3, Chat: Chat{Type: "group"}, Text: "hai"}})
+	d.Handle(Update{CallbackQuery: &CallbackQuery{Data: "x"}})
+	d.Handle(Update{Message: Message{MessageID: 4, Chat: Chat{Type: "group"}}})
+
+	want := []string{"start", "private", "text", "callback", "fallback"}
+	if len(got) != len(want) {
+		t.Fatalf("handlers = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("handlers = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package telegrambot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// ForumTopicOptions berisi parameter opsional createForumTopic. Field yang bernilai nol tidak dikirim.
+type ForumTopicOptions struct {
+	IconColor         int    // icon_color, salah satu warna RGB yang diizinkan Telegram
+	IconCustomEmojiID string // icon_custom_emoji_id
+}
+
+// topicParams membuat form values berisi chat_id dan message_thread_id
+func topicParams(chatID int64, threadID int) url.Values {
+	data := chatParams(chatID)
+	data.Set("message_thread_id", strconv.Itoa(threadID))
+	return data
+}
+
+// CreateForumTopic membuat topik baru di supergroup forum. Bot harus admin dengan hak
+// can_manage_topics. Kirim pesan ke topik dengan SendMessageConfig.MessageThreadID.
+func (b *Bot) CreateForumTopic(chatID int64, name string, opts ForumTopicOptions) (*ForumTopic, error) {
+	data := chatParams(chatID)
+	data.Set("name", name)
+	if opts.IconColor != 0 {
+		data.Set("icon_color", strconv.Itoa(opts.IconColor))
+	}
+	if opts.IconCustomEmojiID != "" {
+		data.Set("icon_custom_emoji_id", opts.IconCustomEmojiID)
+	}
+
+	var topic ForumTopic
+	if err := b.doRequest(context.Background(), "createForumTopic", data, &topic); err != nil {
+		return nil, err
+	}
+	return &topic, nil
+}
+
+// EditForumTopic mengubah nama dan/atau ikon topik; nilai kosong berarti tidak diubah
+func (b *Bot) EditForumTopic(chatID int64, threadID int, name, iconCustomEmojiID string) error {
+	data := topicParams(chatID, threadID)
+	if name != "" {
+		data.Set("name", name)
+	}
+	if iconCustomEmojiID != "" {
+		data.Set("icon_custom_emoji_id", iconCustomEmojiID)
+	}
+	return b.doRequest(context.Background(), "editForumTopic", data, nil)
+}
+
+// CloseForumTopic menutup topik sehingga anggota tidak bisa mengirim pesan ke dalamnya
+func (b *Bot) CloseForumTopic(chatID int64, threadID int) error {
+	return b.doRequest(context.Background(), "closeForumTopic", topicParams(chatID, threadID), nil)
+}
+
+// ReopenForumTopic membuka kembali topik yang ditutup
+func (b *Bot) ReopenForumTopic(chatID int64, threadID int) error {
+	return b.doRequest(context.Background(), "reopenForumTopic", topicParams(chatID, threadID), nil)
+}
+
+// DeleteForumTopic menghapus topik beserta semua pesannya
+func (b *Bot) DeleteForumTopic(chatID int64, threadID int) error {
+	return b.doRequest(context.Background(), "deleteForumTopic", topicParams(chatID, threadID), nil)
+}
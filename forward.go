@@ -0,0 +1,156 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+)
+
+// ForwardOptions berisi parameter opsional forwardMessage
+type ForwardOptions struct {
+	MessageThreadID     int  `json:"message_thread_id,omitempty"` // topik forum tujuan
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	ProtectContent      bool `json:"protect_content,omitempty"`
+}
+
+// CopyOptions berisi parameter opsional copyMessage. Caption menggantikan caption asli.
+type CopyOptions struct {
+	Caption             string          `json:"caption,omitempty"`
+	ParseMode           string          `json:"parse_mode,omitempty"`        // parse_mode untuk caption
+	CaptionEntities     []MessageEntity `json:"caption_entities,omitempty"`  // pengganti ParseMode
+	MessageThreadID     int             `json:"message_thread_id,omitempty"` // topik forum tujuan
+	DisableNotification bool            `json:"disable_notification,omitempty"`
+	ProtectContent      bool            `json:"protect_content,omitempty"`
+	ReplyMarkup         ReplyMarkup     `json:"reply_markup,omitempty"`
+}
+
+// relay menunjuk chat tujuan dan chat asal untuk forward/copy
+type relay struct {
+	ChatID     int64 `json:"chat_id"`
+	FromChatID int64 `json:"from_chat_id"`
+}
+
+// forwardMessageRequest adalah body JSON forwardMessage
+type forwardMessageRequest struct {
+	relay
+	MessageID int `json:"message_id"`
+	ForwardOptions
+}
+
+// ForwardMessage meneruskan pesan dari fromChatID ke toChatID
+func (b *Bot) ForwardMessage(toChatID, fromChatID int64, messageID int) (*Message, error) {
+	return b.ForwardMessageWithOptions(toChatID, fromChatID, messageID, ForwardOptions{})
+}
+
+// ForwardMessageWithOptions sama dengan ForwardMessage dengan opsi tambahan
+func (b *Bot) ForwardMessageWithOptions(toChatID, fromChatID int64, messageID int, opts ForwardOptions) (*Message, error) {
+	req := forwardMessageRequest{relay: relay{toChatID, fromChatID}, MessageID: messageID, ForwardOptions: opts}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "forwardMessage", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// copyMessageRequest adalah body JSON copyMessage
+type copyMessageRequest struct {
+	relay
+	MessageID int `json:"message_id"`
+	CopyOptions
+}
+
+// CopyMessage menyalin pesan tanpa tautan ke pesan asli dan mengembalikan message_id baru
+func (b *Bot) CopyMessage(toChatID, fromChatID int64, messageID int) (int, error) {
+	return b.CopyMessageWithOptions(toChatID, fromChatID, messageID, CopyOptions{})
+}
+
+// CopyMessageWithOptions sama dengan CopyMessage dengan opsi tambahan
+func (b *Bot) CopyMessageWithOptions(toChatID, fromChatID int64, messageID int, opts CopyOptions) (int, error) {
+	if err := validateFormatting(opts.ParseMode, opts.CaptionEntities); err != nil {
+		return 0, err
+	}
+	req := copyMessageRequest{relay: relay{toChatID, fromChatID}, MessageID: messageID, CopyOptions: opts}
+
+	var id MessageID
+	if err := b.doJSONRequest(context.Background(), "copyMessage", req, &id); err != nil {
+		return 0, err
+	}
+	return id.MessageID, nil
+}
+
+// maxBatchMessages adalah jumlah maksimum pesan per forwardMessages/copyMessages
+const maxBatchMessages = 100
+
+// CopyMessagesOptions berisi parameter opsional copyMessages
+type CopyMessagesOptions struct {
+	MessageThreadID     int  `json:"message_thread_id,omitempty"` // topik forum tujuan
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	ProtectContent      bool `json:"protect_content,omitempty"`
+	RemoveCaption       bool `json:"remove_caption,omitempty"` // salin tanpa caption asli
+}
+
+// batchRequest adalah bagian body JSON yang sama untuk forwardMessages dan copyMessages
+type batchRequest struct {
+	relay
+	MessageIDs []int `json:"message_ids"`
+}
+
+// newBatchRequest memvalidasi messageIDs lalu membuat batchRequest
+func newBatchRequest(method string, toChatID, fromChatID int64, messageIDs []int) (batchRequest, error) {
+	if len(messageIDs) == 0 || len(messageIDs) > maxBatchMessages {
+		return batchRequest{}, fmt.Errorf("telegram: %s: need 1-%d message ids, got %d", method, maxBatchMessages, len(messageIDs))
+	}
+	return batchRequest{relay: relay{toChatID, fromChatID}, MessageIDs: messageIDs}, nil
+}
+
+// forwardMessagesRequest adalah body JSON forwardMessages
+type forwardMessagesRequest struct {
+	batchRequest
+	ForwardOptions
+}
+
+// copyMessagesRequest adalah body JSON copyMessages
+type copyMessagesRequest struct {
+	batchRequest
+	CopyMessagesOptions
+}
+
+// messageIDList mengubah hasil batch menjadi slice message_id
+func messageIDList(ids []MessageID) []int {
+	out := make([]int, len(ids))
+	for i, id := range ids {
+		out[i] = id.MessageID
+	}
+	return out
+}
+
+// ForwardMessages meneruskan hingga 100 pesan sekaligus dan mengembalikan message_id barunya.
+// Album tetap dikelompokkan; pesan yang tidak dapat diteruskan dilewati.
+func (b *Bot) ForwardMessages(toChatID, fromChatID int64, messageIDs []int, opts ForwardOptions) ([]int, error) {
+	batch, err := newBatchRequest("forwardMessages", toChatID, fromChatID, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	req := forwardMessagesRequest{batch, opts}
+
+	var ids []MessageID
+	if err := b.doJSONRequest(context.Background(), "forwardMessages", req, &ids); err != nil {
+		return nil, err
+	}
+	return messageIDList(ids), nil
+}
+
+// CopyMessages menyalin hingga 100 pesan sekaligus dan mengembalikan message_id barunya
+func (b *Bot) CopyMessages(toChatID, fromChatID int64, messageIDs []int, opts CopyMessagesOptions) ([]int, error) {
+	batch, err := newBatchRequest("copyMessages", toChatID, fromChatID, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	req := copyMessagesRequest{batch, opts}
+
+	var ids []MessageID
+	if err := b.doJSONRequest(context.Background(), "copyMessages", req, &ids); err != nil {
+		return nil, err
+	}
+	return messageIDList(ids), nil
+}
@@ -0,0 +1,106 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// sendGameRequest adalah body JSON sendGame
+type sendGameRequest struct {
+	ChatID        int64                 `json:"chat_id"`
+	GameShortName string                `json:"game_short_name"`
+	ReplyMarkup   *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendGame mengirim game yang didaftarkan lewat BotFather. markup nil memakai tombol
+// "Play" bawaan; jika diisi, tombol pertama harus dibuat dengan NewInlineButtonGame.
+func (b *Bot) SendGame(chatID int64, gameShortName string, markup *InlineKeyboardMarkup) (*Message, error) {
+	req := sendGameRequest{ChatID: chatID, GameShortName: gameShortName, ReplyMarkup: markup}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "sendGame", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// GameScoreOptions menunjuk pesan game (ChatID dan MessageID, atau InlineMessageID) dan
+// opsi setGameScore. Field yang bernilai nol tidak dikirim.
+type GameScoreOptions struct {
+	ChatID          int64  `json:"chat_id,omitempty"`
+	MessageID       int    `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+
+	Force              bool `json:"force,omitempty"`                // izinkan skor turun, misalnya untuk koreksi
+	DisableEditMessage bool `json:"disable_edit_message,omitempty"` // jangan ubah pesan game dengan tabel skor baru
+}
+
+// validate memastikan pesan game ditunjuk dengan salah satu cara
+func (o GameScoreOptions) validate(method string) error {
+	if o.InlineMessageID == "" && (o.ChatID == 0 || o.MessageID == 0) {
+		return fmt.Errorf("telegram: %s: need ChatID and MessageID, or InlineMessageID", method)
+	}
+	return nil
+}
+
+// setGameScoreRequest adalah body JSON setGameScore
+type setGameScoreRequest struct {
+	UserID int `json:"user_id"`
+	Score  int `json:"score"`
+	GameScoreOptions
+}
+
+// SetGameScore mengatur skor user. Untuk pesan inline hasilnya nil, karena Telegram hanya
+// membalas true; untuk pesan biasa dikembalikan pesan game yang sudah diperbarui.
+// Telegram menolak skor yang tidak lebih tinggi kecuali Force diaktifkan.
+func (b *Bot) SetGameScore(userID int, score int, opts GameScoreOptions) (*Message, error) {
+	if err := opts.validate("setGameScore"); err != nil {
+		return nil, err
+	}
+	if score < 0 {
+		return nil, fmt.Errorf("telegram: setGameScore: negative score %d", score)
+	}
+
+	var raw json.RawMessage
+	req := setGameScoreRequest{UserID: userID, Score: score, GameScoreOptions: opts}
+	if err := b.doJSONRequest(context.Background(), "setGameScore", req, &raw); err != nil {
+		return nil, err
+	}
+	if opts.InlineMessageID != "" {
+		return nil, nil
+	}
+	var msg Message
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return nil, fmt.Errorf("telegram: setGameScore: decode result: %w", err)
+	}
+	return &msg, nil
+}
+
+// getGameHighScoresRequest adalah body JSON getGameHighScores
+type getGameHighScoresRequest struct {
+	UserID          int    `json:"user_id"`
+	ChatID          int64  `json:"chat_id,omitempty"`
+	MessageID       int    `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// GetGameHighScores mengambil tabel skor tertinggi di sekitar posisi user. Force dan
+// DisableEditMessage pada opts diabaikan.
+func (b *Bot) GetGameHighScores(userID int, opts GameScoreOptions) ([]GameHighScore, error) {
+	if err := opts.validate("getGameHighScores"); err != nil {
+		return nil, err
+	}
+	req := getGameHighScoresRequest{
+		UserID:          userID,
+		ChatID:          opts.ChatID,
+		MessageID:       opts.MessageID,
+		InlineMessageID: opts.InlineMessageID,
+	}
+
+	var scores []GameHighScore
+	if err := b.doJSONRequest(context.Background(), "getGameHighScores", req, &scores); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
@@ -0,0 +1,56 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMessageGame(t *testing.T) {
+	payload := `{
+		"message_id": 5,
+		"chat": {"id": 1, "type": "private"},
+		"game": {
+			"title": "Ular",
+			"description": "Makan apel",
+			"photo": [{"file_id": "p", "file_unique_id": "u", "width": 640, "height": 360}],
+			"text": "Skor: 12"
+		},
+		"reply_markup": {"inline_keyboard": [[{"text": "Main", "callback_game": {}}]]}
+	}`
+	var msg Message
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if msg.Game == nil || msg.Game.Title != "Ular" || len(msg.Game.Photo) != 1 || msg.Game.Text != "Skor: 12" {
+		t.Fatalf("Game = %+v", msg.Game)
+	}
+
+	got, _ := json.Marshal(NewInlineButtonGame("Main"))
+	if string(got) != `{"text":"Main","callback_game":{}}` {
+		t.Errorf("NewInlineButtonGame JSON = %s", got)
+	}
+}
+
+func TestSetGameScore(t *testing.T) {
+	bot, _, body := captureServer(t, `{"message_id":5}`)
+	msg, err := bot.SetGameScore(7, 12, GameScoreOptions{ChatID: 1, MessageID: 5, Force: true})
+	if err != nil {
+		t.Fatalf("SetGameScore: %v", err)
+	}
+	if msg == nil || msg.MessageID != 5 {
+		t.Errorf("SetGameScore = %+v, want edited message", msg)
+	}
+	if (*body)["user_id"] != float64(7) || (*body)["score"] != float64(12) || (*body)["force"] != true {
+		t.Errorf("body = %v", *body)
+	}
+
+	inline, _, _ := captureServer(t, `true`)
+	msg, err = inline.SetGameScore(7, 12, GameScoreOptions{InlineMessageID: "inline-1"})
+	if err != nil || msg != nil {
+		t.Errorf("inline SetGameScore = %+v, %v; want nil, nil", msg, err)
+	}
+
+	if _, err := bot.GetGameHighScores(7, GameScoreOptions{ChatID: 1}); err == nil {
+		t.Error("GetGameHighScores without MessageID should fail")
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/VampXDH/telegram-bot-package
+
+go 1.18
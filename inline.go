@@ -0,0 +1,88 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// InlineQueryResult adalah satu hasil untuk AnswerInlineQuery, misalnya
+// InlineQueryResultArticle atau InlineQueryResultPhoto
+type InlineQueryResult interface {
+	inlineQueryResult()
+}
+
+// InputTextMessageContent adalah isi pesan teks yang dikirim ketika hasil inline dipilih
+type InputTextMessageContent struct {
+	MessageText string `json:"message_text"`
+	ParseMode   string `json:"parse_mode,omitempty"`
+}
+
+// InlineQueryResultArticle adalah hasil inline berupa artikel yang mengirim pesan teks
+type InlineQueryResultArticle struct {
+	ID                  string                  `json:"id"`
+	Title               string                  `json:"title"`
+	InputMessageContent InputTextMessageContent `json:"input_message_content"`
+	ReplyMarkup         *InlineKeyboardMarkup   `json:"reply_markup,omitempty"`
+	URL                 string                  `json:"url,omitempty"`
+	Description         string                  `json:"description,omitempty"`
+	ThumbnailURL        string                  `json:"thumbnail_url,omitempty"`
+}
+
+func (InlineQueryResultArticle) inlineQueryResult() {}
+
+// MarshalJSON menambahkan field type "article"
+func (r InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultArticle
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		plain
+	}{"article", plain(r)})
+}
+
+// InlineQueryResultPhoto adalah hasil inline berupa foto dari URL
+type InlineQueryResultPhoto struct {
+	ID           string                `json:"id"`
+	PhotoURL     string                `json:"photo_url"`
+	ThumbnailURL string                `json:"thumbnail_url"`
+	PhotoWidth   int                   `json:"photo_width,omitempty"`
+	PhotoHeight  int                   `json:"photo_height,omitempty"`
+	Title        string                `json:"title,omitempty"`
+	Description  string                `json:"description,omitempty"`
+	Caption      string                `json:"caption,omitempty"`
+	ParseMode    string                `json:"parse_mode,omitempty"`
+	ReplyMarkup  *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+func (InlineQueryResultPhoto) inlineQueryResult() {}
+
+// MarshalJSON menambahkan field type "photo"
+func (r InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type plain InlineQueryResultPhoto
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		plain
+	}{"photo", plain(r)})
+}
+
+// InlineQueryOptions berisi parameter opsional answerInlineQuery. Field yang bernilai nol tidak dikirim.
+type InlineQueryOptions struct {
+	CacheTime  int    `json:"cache_time,omitempty"`  // dalam detik; nol memakai default Telegram (300)
+	IsPersonal bool   `json:"is_personal,omitempty"` // hasil hanya di-cache untuk user yang bertanya
+	NextOffset string `json:"next_offset,omitempty"` // offset untuk memuat hasil berikutnya
+}
+
+// answerInlineQueryRequest adalah body JSON answerInlineQuery
+type answerInlineQueryRequest struct {
+	InlineQueryID string              `json:"inline_query_id"`
+	Results       []InlineQueryResult `json:"results"`
+	InlineQueryOptions
+}
+
+// AnswerInlineQuery mengirim hasil untuk inline query (maksimum 50 hasil)
+func (b *Bot) AnswerInlineQuery(queryID string, results []InlineQueryResult, opts InlineQueryOptions) error {
+	if results == nil {
+		results = []InlineQueryResult{}
+	}
+	req := answerInlineQueryRequest{InlineQueryID: queryID, Results: results, InlineQueryOptions: opts}
+	return b.doJSONRequest(context.Background(), "answerInlineQuery", req, nil)
+}
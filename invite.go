@@ -0,0 +1,86 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// InviteLinkOptions berisi parameter opsional createChatInviteLink. Field yang bernilai nol tidak dikirim.
+type InviteLinkOptions struct {
+	Name               string    // name, 0-32 karakter
+	ExpireDate         time.Time // expire_date
+	MemberLimit        int       // member_limit, 1-99999
+	CreatesJoinRequest bool      // creates_join_request; tidak boleh bersamaan dengan MemberLimit
+}
+
+// params menambahkan opsi ke form values
+func (o InviteLinkOptions) params(data url.Values) {
+	if o.Name != "" {
+		data.Set("name", o.Name)
+	}
+	if !o.ExpireDate.IsZero() {
+		data.Set("expire_date", strconv.FormatInt(o.ExpireDate.Unix(), 10))
+	}
+	if o.MemberLimit != 0 {
+		data.Set("member_limit", strconv.Itoa(o.MemberLimit))
+	}
+	if o.CreatesJoinRequest {
+		data.Set("creates_join_request", "true")
+	}
+}
+
+// LeaveChat membuat bot keluar dari grup, supergroup, atau channel
+func (b *Bot) LeaveChat(chatID int64) error {
+	return b.doRequest(context.Background(), "leaveChat", chatParams(chatID), nil)
+}
+
+// ExportChatInviteLink membuat invite link utama yang baru; link utama sebelumnya dicabut
+func (b *Bot) ExportChatInviteLink(chatID int64) (string, error) {
+	var link string
+	if err := b.doRequest(context.Background(), "exportChatInviteLink", chatParams(chatID), &link); err != nil {
+		return "", err
+	}
+	return link, nil
+}
+
+// CreateChatInviteLink membuat invite link tambahan sesuai opsi. Dengan CreatesJoinRequest,
+// user yang memakai link menghasilkan Update.ChatJoinRequest yang harus disetujui lewat
+// ApproveChatJoinRequest atau ditolak lewat DeclineChatJoinRequest.
+func (b *Bot) CreateChatInviteLink(chatID int64, opts InviteLinkOptions) (*ChatInviteLink, error) {
+	if opts.CreatesJoinRequest && opts.MemberLimit != 0 {
+		return nil, fmt.Errorf("telegram: createChatInviteLink: MemberLimit cannot be combined with CreatesJoinRequest")
+	}
+	data := chatParams(chatID)
+	opts.params(data)
+
+	var link ChatInviteLink
+	if err := b.doRequest(context.Background(), "createChatInviteLink", data, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// RevokeChatInviteLink mencabut invite link yang dibuat bot
+func (b *Bot) RevokeChatInviteLink(chatID int64, inviteLink string) (*ChatInviteLink, error) {
+	data := chatParams(chatID)
+	data.Set("invite_link", inviteLink)
+
+	var link ChatInviteLink
+	if err := b.doRequest(context.Background(), "revokeChatInviteLink", data, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// ApproveChatJoinRequest menyetujui permintaan bergabung user ke chat
+func (b *Bot) ApproveChatJoinRequest(chatID int64, userID int) error {
+	return b.doRequest(context.Background(), "approveChatJoinRequest", chatUserParams(chatID, userID), nil)
+}
+
+// DeclineChatJoinRequest menolak permintaan bergabung user ke chat
+func (b *Bot) DeclineChatJoinRequest(chatID int64, userID int) error {
+	return b.doRequest(context.Background(), "declineChatJoinRequest", chatUserParams(chatID, userID), nil)
+}
@@ -0,0 +1,53 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChatJoinRequest(t *testing.T) {
+	payload := `{
+		"update_id": 9,
+		"chat_join_request": {
+			"chat": {"id": -100, "type": "supergroup", "title": "Komunitas"},
+			"from": {"id": 7, "first_name": "Budi"},
+			"user_chat_id": 7,
+			"date": 1700000000,
+			"invite_link": {"invite_link": "https://t.me/+abc", "creates_join_request": true}
+		}
+	}`
+	var u Update
+	if err := json.Unmarshal([]byte(payload), &u); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	req := u.ChatJoinRequest
+	if req == nil || req.Chat.ID != -100 || req.From.ID != 7 || req.InviteLink == nil || !req.InviteLink.CreatesJoinRequest {
+		t.Fatalf("ChatJoinRequest = %+v", req)
+	}
+
+	var form map[string]string
+	var method string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		method = r.URL.Path[len("/bot123:abc/"):]
+		form = map[string]string{"chat_id": r.PostForm.Get("chat_id"), "user_id": r.PostForm.Get("user_id")}
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	if err := bot.ApproveChatJoinRequest(req.Chat.ID, req.From.ID); err != nil {
+		t.Fatalf("ApproveChatJoinRequest: %v", err)
+	}
+	if method != "approveChatJoinRequest" || form["chat_id"] != "-100" || form["user_id"] != "7" {
+		t.Errorf("request = %s %v", method, form)
+	}
+
+	_, err := bot.CreateChatInviteLink(-100, InviteLinkOptions{CreatesJoinRequest: true, MemberLimit: 10})
+	if err == nil {
+		t.Error("CreatesJoinRequest with MemberLimit should fail")
+	}
+}
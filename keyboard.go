@@ -0,0 +1,74 @@
+package telegrambot
+
+// InlineKeyboardBuilder membantu menyusun InlineKeyboardMarkup baris demi baris
+type InlineKeyboardBuilder struct {
+	rows [][]InlineKeyboardButton
+}
+
+// NewInlineKeyboard membuat builder inline keyboard kosong
+func NewInlineKeyboard() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Row menambahkan satu baris tombol
+func (k *InlineKeyboardBuilder) Row(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	k.rows = append(k.rows, buttons)
+	return k
+}
+
+// Build mengembalikan InlineKeyboardMarkup yang siap dipasang ke pesan
+func (k *InlineKeyboardBuilder) Build() *InlineKeyboardMarkup {
+	return &InlineKeyboardMarkup{InlineKeyboard: k.rows}
+}
+
+// NewInlineButtonData membuat tombol inline dengan callback_data
+func NewInlineButtonData(text, data string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CallbackData: data}
+}
+
+// NewInlineButtonURL membuat tombol inline yang membuka URL
+func NewInlineButtonURL(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, URL: url}
+}
+
+// NewInlineButtonWebApp membuat tombol inline yang membuka Web App di url
+func NewInlineButtonWebApp(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// NewInlineButtonGame membuat tombol inline yang membuka game pesan; harus menjadi tombol pertama
+func NewInlineButtonGame(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CallbackGame: &CallbackGame{}}
+}
+
+// NewReplyKeyboard membuat reply keyboard dari baris-baris tombol dengan resize_keyboard aktif
+func NewReplyKeyboard(rows ...[]KeyboardButton) *ReplyKeyboardMarkup {
+	return &ReplyKeyboardMarkup{Keyboard: rows, ResizeKeyboard: true}
+}
+
+// NewKeyboardRow membuat satu baris tombol reply keyboard
+func NewKeyboardRow(buttons ...KeyboardButton) []KeyboardButton {
+	return buttons
+}
+
+// NewKeyboardButton membuat tombol reply keyboard yang mengirim teksnya sebagai pesan
+func NewKeyboardButton(text string) KeyboardButton {
+	return KeyboardButton{Text: text}
+}
+
+// NewKeyboardButtonContact membuat tombol yang meminta nomor telepon pengguna
+func NewKeyboardButtonContact(text string) KeyboardButton {
+	return KeyboardButton{Text: text, RequestContact: true}
+}
+
+// NewKeyboardButtonLocation membuat tombol yang meminta lokasi pengguna; lokasi yang dibagikan
+// diterima sebagai Message.Location
+func NewKeyboardButtonLocation(text string) KeyboardButton {
+	return KeyboardButton{Text: text, RequestLocation: true}
+}
+
+// NewKeyboardButtonWebApp membuat tombol yang membuka Web App; data yang dikirim Web App
+// lewat Telegram.WebApp.sendData diterima sebagai Message.WebAppData
+func NewKeyboardButtonWebApp(text, url string) KeyboardButton {
+	return KeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
@@ -0,0 +1,106 @@
+package telegrambot
+
+import "context"
+
+// LocationOptions berisi parameter opsional sendLocation. Field yang bernilai nol tidak dikirim.
+type LocationOptions struct {
+	LivePeriod           int         `json:"live_period,omitempty"`            // dalam detik, untuk lokasi live (60-86400)
+	HorizontalAccuracy   float64     `json:"horizontal_accuracy,omitempty"`    // dalam meter (0-1500)
+	Heading              int         `json:"heading,omitempty"`                // dalam derajat (1-360), untuk lokasi live
+	ProximityAlertRadius int         `json:"proximity_alert_radius,omitempty"` // dalam meter, untuk lokasi live
+	MessageThreadID      int         `json:"message_thread_id,omitempty"`      // topik forum tujuan
+	ReplyToMessageID     int         `json:"reply_to_message_id,omitempty"`
+	DisableNotification  bool        `json:"disable_notification,omitempty"`
+	ProtectContent       bool        `json:"protect_content,omitempty"`
+	ReplyMarkup          ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// coordinates adalah latitude dan longitude di body JSON
+type coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// sendLocationRequest adalah body JSON sendLocation
+type sendLocationRequest struct {
+	ChatID int64 `json:"chat_id"`
+	coordinates
+	LocationOptions
+}
+
+// SendLocation mengirim titik lokasi; isi opts.LivePeriod untuk lokasi live
+func (b *Bot) SendLocation(chatID int64, lat, lon float64, opts LocationOptions) (*Message, error) {
+	req := sendLocationRequest{ChatID: chatID, coordinates: coordinates{lat, lon}, LocationOptions: opts}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "sendLocation", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// sendVenueRequest adalah body JSON sendVenue
+type sendVenueRequest struct {
+	ChatID int64 `json:"chat_id"`
+	coordinates
+	Title   string `json:"title"`
+	Address string `json:"address"`
+	SendOptions
+}
+
+// SendVenue mengirim informasi tempat beserta nama dan alamatnya
+func (b *Bot) SendVenue(chatID int64, lat, lon float64, title, address string) (*Message, error) {
+	return b.SendVenueWithOptions(chatID, lat, lon, title, address, SendOptions{})
+}
+
+// SendVenueWithOptions sama dengan SendVenue dengan opsi tambahan, misalnya MessageThreadID
+func (b *Bot) SendVenueWithOptions(chatID int64, lat, lon float64, title, address string, opts SendOptions) (*Message, error) {
+	req := sendVenueRequest{ChatID: chatID, coordinates: coordinates{lat, lon}, Title: title, Address: address, SendOptions: opts}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "sendVenue", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// EditLiveLocationOptions berisi parameter opsional editMessageLiveLocation. Field yang bernilai
+// nol tidak dikirim.
+type EditLiveLocationOptions struct {
+	LivePeriod           int                   `json:"live_period,omitempty"`            // periode baru dalam detik
+	HorizontalAccuracy   float64               `json:"horizontal_accuracy,omitempty"`    // dalam meter (0-1500)
+	Heading              int                   `json:"heading,omitempty"`                // dalam derajat (1-360)
+	ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty"` // dalam meter
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// editLiveLocationRequest adalah body JSON editMessageLiveLocation
+type editLiveLocationRequest struct {
+	messageRef
+	coordinates
+	EditLiveLocationOptions
+}
+
+// EditMessageLiveLocation memperbarui posisi lokasi live yang masih aktif
+func (b *Bot) EditMessageLiveLocation(chatID int64, messageID int, lat, lon float64, opts EditLiveLocationOptions) (*Message, error) {
+	req := editLiveLocationRequest{
+		messageRef:              messageRef{ChatID: chatID, MessageID: messageID},
+		coordinates:             coordinates{lat, lon},
+		EditLiveLocationOptions: opts,
+	}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "editMessageLiveLocation", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// StopMessageLiveLocation menghentikan pembaruan lokasi live sebelum live_period habis
+func (b *Bot) StopMessageLiveLocation(chatID int64, messageID int) (*Message, error) {
+	var msg Message
+	if err := b.doRequest(context.Background(), "stopMessageLiveLocation", chatMessageParams(chatID, messageID), &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
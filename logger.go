@@ -0,0 +1,47 @@
+package telegrambot
+
+import "fmt"
+
+// Logger menerima log internal package. Implementasikan dengan library logging apa pun,
+// misalnya dengan membungkus *log.Logger, zap atau logrus.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// nopLogger adalah Logger default yang membuang semua log
+type nopLogger struct{}
+
+func (nopLogger) Debugf(string, ...interface{}) {}
+func (nopLogger) Errorf(string, ...interface{}) {}
+
+// SetLogger memasang Logger untuk bot; nil mengembalikan ke default yang tidak mencatat apa pun.
+// Method API, status response dan retry dicatat di level debug, error polling di level error.
+// Token bot selalu disamarkan sebelum diteruskan ke Logger.
+func (b *Bot) SetLogger(logger Logger) {
+	b.logger = logger
+}
+
+// log mengembalikan Logger yang terpasang atau nopLogger
+func (b *Bot) log() Logger {
+	if b.logger == nil {
+		return nopLogger{}
+	}
+	return b.logger
+}
+
+// debugf mencatat pesan di level debug dengan token disamarkan
+func (b *Bot) debugf(format string, args ...interface{}) {
+	if b.logger == nil {
+		return
+	}
+	b.log().Debugf("%s", redactToken(fmt.Sprintf(format, args...)))
+}
+
+// errorf mencatat pesan di level error dengan token disamarkan
+func (b *Bot) errorf(format string, args ...interface{}) {
+	if b.logger == nil {
+		return
+	}
+	b.log().Errorf("%s", redactToken(fmt.Sprintf(format, args...)))
+}
@@ -0,0 +1,115 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MaxMessageLength adalah panjang maksimum teks sendMessage dalam unit UTF-16
+const MaxMessageLength = 4096
+
+// codeFence adalah pembatas blok kode Markdown
+const codeFence = "```"
+
+// SendLongMessage mengirim text yang bisa melebihi MaxMessageLength sebagai beberapa pesan
+// berurutan. Teks dipotong di akhir baris, lalu di spasi, dan hanya dipotong paksa (di batas
+// karakter UTF-8) jika tidak ada keduanya; baris baru di dalam blok ``` dihindari bila ada
+// pilihan lain. cfg dipakai sebagai template: reply hanya untuk potongan pertama dan
+// ReplyMarkup hanya untuk potongan terakhir. Entities tidak didukung karena offset-nya tidak
+// bisa dibagi. Jika satu potongan gagal, pesan yang sudah terkirim dikembalikan bersama error.
+func (b *Bot) SendLongMessage(chatID int64, text string, cfg SendMessageConfig) ([]Message, error) {
+	if len(cfg.Entities) > 0 {
+		return nil, fmt.Errorf("telegram: SendLongMessage: entities cannot be split, use ParseMode")
+	}
+
+	chunks := splitText(text, MaxMessageLength)
+	msgs := make([]Message, 0, len(chunks))
+	for i, chunk := range chunks {
+		msgCfg := cfg
+		msgCfg.ChatID = chatID
+		msgCfg.Chat = ChatID{}
+		msgCfg.Text = chunk
+		if i > 0 {
+			msgCfg.ReplyToMessageID = 0
+			msgCfg.ReplyParameters = nil
+		}
+		if i < len(chunks)-1 {
+			msgCfg.ReplyMarkup = nil
+		}
+
+		msg, err := b.SendContext(context.Background(), msgCfg)
+		if err != nil {
+			return msgs, fmt.Errorf("telegram: SendLongMessage: chunk %d/%d: %w", i+1, len(chunks), err)
+		}
+		msgs = append(msgs, *msg)
+	}
+	return msgs, nil
+}
+
+// splitText memotong text menjadi potongan yang masing-masing paling panjang limit unit UTF-16.
+// Karakter baris baru atau spasi tempat teks dipotong dibuang.
+func splitText(text string, limit int) []string {
+	var chunks []string
+	for utf16Len(text) > limit {
+		cut := utf16Prefix(text, limit)
+		head, rest := text[:cut], text[cut:]
+
+		if i := splitPoint(head, strings.HasPrefix(rest, "\n")); i >= 0 {
+			chunks = append(chunks, head[:i])
+			text = text[i+1:]
+			continue
+		}
+		chunks = append(chunks, head)
+		text = rest
+	}
+	return append(chunks, text)
+}
+
+// splitPoint mencari indeks byte tempat head sebaiknya dipotong: baris baru di luar blok kode,
+// baris baru mana pun, lalu spasi. nextIsNewline menandakan teks sesudah head diawali baris
+// baru, sehingga head utuh juga berakhir di batas baris. Hasil -1 berarti tidak ada.
+func splitPoint(head string, nextIsNewline bool) int {
+	if nextIsNewline && strings.Count(head, codeFence)%2 == 0 {
+		return len(head)
+	}
+	fallback := -1
+	for i := len(head) - 1; i > 0; i-- {
+		if head[i] != '\n' {
+			continue
+		}
+		if strings.Count(head[:i], codeFence)%2 == 0 {
+			return i
+		}
+		if fallback < 0 {
+			fallback = i
+		}
+	}
+	if fallback >= 0 {
+		return fallback
+	}
+	if nextIsNewline {
+		return len(head)
+	}
+	if i := strings.LastIndexByte(head, ' '); i > 0 {
+		return i
+	}
+	return -1
+}
+
+// utf16Prefix mengembalikan indeks byte terbesar di batas rune sehingga s[:i] paling panjang
+// limit unit UTF-16
+func utf16Prefix(s string, limit int) int {
+	n := 0
+	for i, r := range s {
+		w := 1
+		if r >= 0x10000 {
+			w = 2
+		}
+		if n+w > limit {
+			return i
+		}
+		n += w
+	}
+	return len(s)
+}
@@ -0,0 +1,82 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitText(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+		want  []string
+	}{
+		{"short", "halo", 10, []string{"halo"}},
+		{"lines", "satu\ndua\ntiga", 9, []string{"satu\ndua", "tiga"}},
+		{"exact line end", "abcd\nefgh", 4, []string{"abcd", "efgh"}},
+		{"words", "satu dua tiga empat", 9, []string{"satu dua", "tiga", "empat"}},
+		{"hard cut", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		// emoji adalah 2 unit UTF-16 dan tidak boleh terbelah
+		{"surrogate pair", "ab😀cd", 3, []string{"ab", "😀c", "d"}},
+		{"cyrillic", "Привет мир", 7, []string{"Привет", "мир"}},
+		// baris baru di dalam blok kode dilewati jika ada baris baru di luar blok
+		{"code block", "intro\n```\nx := 1\ny := 2\n```", 22, []string{"intro", "```\nx := 1\ny := 2\n```"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitText(tt.text, tt.limit)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Fatalf("splitText = %q, want %q", got, tt.want)
+			}
+			for _, c := range got {
+				if utf16Len(c) > tt.limit || !utf8.ValidString(c) {
+					t.Errorf("chunk %q is invalid or longer than %d", c, tt.limit)
+				}
+			}
+		})
+	}
+}
+
+func TestSendLongMessage(t *testing.T) {
+	var calls int32
+	var bodies []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		n := atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"ok":true,"result":{"message_id":` + strconv.Itoa(int(n)) + `}}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	line := strings.Repeat("x", 99) + "\n"
+	text := strings.Repeat(line, 60) // 6000 karakter
+	kb := NewInlineKeyboard().Row(NewInlineButtonData("OK", "ok")).Build()
+
+	msgs, err := bot.SendLongMessage(1, text, SendMessageConfig{ReplyToMessageID: 5, ReplyMarkup: kb})
+	if err != nil {
+		t.Fatalf("SendLongMessage: %v", err)
+	}
+	if len(msgs) != 2 || msgs[1].MessageID != 2 {
+		t.Fatalf("messages = %+v, want 2", msgs)
+	}
+	if _, ok := bodies[0]["reply_markup"]; ok || bodies[0]["reply_to_message_id"] != float64(5) {
+		t.Errorf("first chunk = %v, want reply without markup", bodies[0])
+	}
+	if _, ok := bodies[1]["reply_to_message_id"]; ok || bodies[1]["reply_markup"] == nil {
+		t.Errorf("last chunk = %v, want markup without reply", bodies[1])
+	}
+	first := bodies[0]["text"].(string)
+	if len(first) > MaxMessageLength || strings.HasSuffix(first, "\n") || len(first)%100 != 99 {
+		t.Errorf("first chunk has %d bytes, want split at a line end", len(first))
+	}
+}
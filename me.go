@@ -0,0 +1,129 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// GetMe mengambil identitas bot. Hasilnya disimpan sehingga panggilan berikutnya dan Self
+// tidak perlu request lagi.
+func (b *Bot) GetMe() (*User, error) {
+	return b.getMe(context.Background())
+}
+
+// Validate memeriksa token dengan memanggil getMe (tanpa cache). Token yang ditolak API
+// menghasilkan error "invalid bot token" yang memenuhi IsUnauthorized; error jaringan
+// dikembalikan apa adanya sehingga keduanya bisa dibedakan.
+//
+//	bot := telegrambot.NewBot(os.Getenv("BOT_TOKEN"))
+//	if err := bot.Validate(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+func (b *Bot) Validate(ctx context.Context) error {
+	if _, err := b.fetchMe(ctx); err != nil {
+		if IsUnauthorized(err) {
+			return fmt.Errorf("telegram: invalid bot token: %w", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// getMe memanggil getMe bila identitas bot belum tersimpan
+func (b *Bot) getMe(ctx context.Context) (*User, error) {
+	if self := b.Self(); self != nil {
+		return self, nil
+	}
+	return b.fetchMe(ctx)
+}
+
+// fetchMe selalu memanggil getMe lalu menyimpan hasilnya untuk Self
+func (b *Bot) fetchMe(ctx context.Context) (*User, error) {
+	var user User
+	if err := b.doRequest(ctx, "getMe", url.Values{}, &user); err != nil {
+		return nil, err
+	}
+
+	b.selfMu.Lock()
+	b.self = &user
+	b.selfMu.Unlock()
+	return &user, nil
+}
+
+// Self mengembalikan identitas bot yang tersimpan, atau nil jika GetMe belum pernah berhasil
+func (b *Bot) Self() *User {
+	b.selfMu.RLock()
+	defer b.selfMu.RUnlock()
+	return b.self
+}
+
+// LogOut mengeluarkan bot dari server Bot API cloud. Wajib dipanggil sebelum pindah ke
+// Local Bot API Server; setelahnya bot tidak dapat masuk ke server cloud selama 10 menit.
+func (b *Bot) LogOut() error {
+	return b.doRequest(context.Background(), "logOut", url.Values{}, nil)
+}
+
+// Close menutup instance bot di Local Bot API Server sebelum dipindahkan ke server lain.
+// Hapus webhook terlebih dahulu agar bot tidak langsung dibuka kembali.
+func (b *Bot) Close() error {
+	return b.doRequest(context.Background(), "close", url.Values{}, nil)
+}
+
+// languageParams membuat form values berisi language_code jika lang diisi
+func languageParams(lang string) url.Values {
+	data := url.Values{}
+	if lang != "" {
+		data.Set("language_code", lang)
+	}
+	return data
+}
+
+// setBotText memanggil method pengatur teks profil bot; text kosong menghapus teks untuk bahasa tersebut
+func (b *Bot) setBotText(method, field, text, lang string) error {
+	data := languageParams(lang)
+	if text != "" {
+		data.Set(field, text)
+	}
+	return b.doRequest(context.Background(), method, data, nil)
+}
+
+// getBotText memanggil method pembaca teks profil bot dan mengambil field dari hasilnya
+func (b *Bot) getBotText(method, field, lang string) (string, error) {
+	var result map[string]string
+	if err := b.doRequest(context.Background(), method, languageParams(lang), &result); err != nil {
+		return "", err
+	}
+	return result[field], nil
+}
+
+// SetMyName mengubah nama bot (0-64 karakter). lang adalah kode bahasa IETF; kosong berarti
+// nama untuk semua user yang bahasanya tidak punya nama khusus.
+func (b *Bot) SetMyName(name, lang string) error {
+	return b.setBotText("setMyName", "name", name, lang)
+}
+
+// GetMyName mengambil nama bot untuk bahasa lang
+func (b *Bot) GetMyName(lang string) (string, error) {
+	return b.getBotText("getMyName", "name", lang)
+}
+
+// SetMyDescription mengubah deskripsi yang tampil di chat kosong dengan bot (0-512 karakter)
+func (b *Bot) SetMyDescription(description, lang string) error {
+	return b.setBotText("setMyDescription", "description", description, lang)
+}
+
+// GetMyDescription mengambil deskripsi bot untuk bahasa lang
+func (b *Bot) GetMyDescription(lang string) (string, error) {
+	return b.getBotText("getMyDescription", "description", lang)
+}
+
+// SetMyShortDescription mengubah deskripsi singkat di halaman profil bot (0-120 karakter)
+func (b *Bot) SetMyShortDescription(shortDescription, lang string) error {
+	return b.setBotText("setMyShortDescription", "short_description", shortDescription, lang)
+}
+
+// GetMyShortDescription mengambil deskripsi singkat bot untuk bahasa lang
+func (b *Bot) GetMyShortDescription(lang string) (string, error) {
+	return b.getBotText("getMyShortDescription", "short_description", lang)
+}
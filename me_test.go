@@ -0,0 +1,77 @@
+package telegrambot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBotProfileTexts(t *testing.T) {
+	var form map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		form = map[string]string{}
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+		if strings.HasSuffix(r.URL.Path, "/getMyShortDescription") {
+			w.Write([]byte(`{"ok":true,"result":{"short_description":"Bot cuaca"}}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	if err := bot.SetMyDescription("Deskripsi", "id"); err != nil {
+		t.Fatalf("SetMyDescription: %v", err)
+	}
+	if form["description"] != "Deskripsi" || form["language_code"] != "id" {
+		t.Errorf("setMyDescription form = %v", form)
+	}
+
+	got, err := bot.GetMyShortDescription("")
+	if err != nil {
+		t.Fatalf("GetMyShortDescription: %v", err)
+	}
+	if got != "Bot cuaca" {
+		t.Errorf("GetMyShortDescription = %q, want %q", got, "Bot cuaca")
+	}
+	if _, ok := form["language_code"]; ok {
+		t.Errorf("empty lang should not send language_code: %v", form)
+	}
+}
+
+func TestValidateRefreshesSelf(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 3 {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"ok":false,"error_code":401,"description":"Unauthorized"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"id":42,"is_bot":true,"first_name":"Bot","username":"bot` + strings.Repeat("x", calls) + `"}}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	if _, err := bot.GetMe(); err != nil {
+		t.Fatalf("GetMe: %v", err)
+	}
+	// Validate tidak memakai cache, tetapi memperbarui Self
+	if err := bot.Validate(context.Background()); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if calls != 2 || bot.Self().Username != "botxx" {
+		t.Fatalf("calls = %d, Self = %+v; want a fresh getMe", calls, bot.Self())
+	}
+	err := bot.Validate(context.Background())
+	if !IsUnauthorized(err) || !strings.Contains(err.Error(), "invalid bot token") {
+		t.Fatalf("Validate err = %v, want invalid bot token", err)
+	}
+}
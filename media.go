@@ -0,0 +1,241 @@
+package telegrambot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// MediaConfig berisi parameter yang sama untuk semua method pengiriman media.
+// Field yang bernilai nol tidak dikirim.
+type MediaConfig struct {
+	ChatID              int64           // chat_id
+	Chat                ChatID          // chat_id berupa @username; jika diisi, ChatID diabaikan
+	Caption             string          // caption
+	ParseMode           string          // parse_mode untuk caption
+	CaptionEntities     []MessageEntity // caption_entities, pengganti ParseMode
+	MessageThreadID     int             // message_thread_id, topik forum tujuan
+	ReplyToMessageID    int             // reply_to_message_id
+	DisableNotification bool            // disable_notification
+	ProtectContent      bool            // protect_content
+	ReplyMarkup         ReplyMarkup     // reply_markup, di-serialize sebagai JSON
+}
+
+// params mengubah config menjadi form values
+func (c MediaConfig) params() (url.Values, error) {
+	if err := validateFormatting(c.ParseMode, c.CaptionEntities); err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("chat_id", chatIDValue(c.ChatID, c.Chat))
+	if c.Caption != "" {
+		data.Set("caption", c.Caption)
+	}
+	if c.ParseMode != "" {
+		data.Set("parse_mode", c.ParseMode)
+	}
+	if len(c.CaptionEntities) > 0 {
+		if err := setJSON(data, "caption_entities", c.CaptionEntities); err != nil {
+			return nil, err
+		}
+	}
+	setOptionalInt(data, "message_thread_id", c.MessageThreadID)
+	if c.ReplyToMessageID != 0 {
+		data.Set("reply_to_message_id", strconv.Itoa(c.ReplyToMessageID))
+	}
+	if c.DisableNotification {
+		data.Set("disable_notification", "true")
+	}
+	if c.ProtectContent {
+		data.Set("protect_content", "true")
+	}
+	if c.ReplyMarkup != nil {
+		if err := setJSON(data, "reply_markup", c.ReplyMarkup); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// sendMedia mengirim satu file media lewat method dengan field file tertentu
+func (b *Bot) sendMedia(ctx context.Context, method string, params url.Values, field string, file InputFile) (*Message, error) {
+	var msg Message
+	if err := b.doUpload(ctx, method, params, []uploadFile{{field: field, file: file}}, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// PhotoConfig berisi parameter sendPhoto
+type PhotoConfig struct {
+	MediaConfig
+	Photo InputFile // photo
+}
+
+// SendPhoto mengirim foto dari file lokal, reader, URL, atau file_id
+func (b *Bot) SendPhoto(chatID int64, photo InputFile, caption string) (*Message, error) {
+	return b.SendPhotoWithConfig(context.Background(), PhotoConfig{
+		MediaConfig: MediaConfig{ChatID: chatID, Caption: caption},
+		Photo:       photo,
+	})
+}
+
+// SendPhotoWithConfig mengirim foto sesuai PhotoConfig
+func (b *Bot) SendPhotoWithConfig(ctx context.Context, cfg PhotoConfig) (*Message, error) {
+	data, err := cfg.params()
+	if err != nil {
+		return nil, err
+	}
+	return b.sendMedia(ctx, "sendPhoto", data, "photo", cfg.Photo)
+}
+
+// DocumentConfig berisi parameter sendDocument
+type DocumentConfig struct {
+	MediaConfig
+	Document InputFile // document
+}
+
+// SendDocument mengirim file sembarang; Message.Document hasilnya berisi file_id baru
+func (b *Bot) SendDocument(chatID int64, doc InputFile, caption string) (*Message, error) {
+	return b.SendDocumentWithConfig(context.Background(), DocumentConfig{
+		MediaConfig: MediaConfig{ChatID: chatID, Caption: caption},
+		Document:    doc,
+	})
+}
+
+// SendDocumentWithConfig mengirim dokumen sesuai DocumentConfig
+func (b *Bot) SendDocumentWithConfig(ctx context.Context, cfg DocumentConfig) (*Message, error) {
+	data, err := cfg.params()
+	if err != nil {
+		return nil, err
+	}
+	return b.sendMedia(ctx, "sendDocument", data, "document", cfg.Document)
+}
+
+// setOptionalInt menambahkan field angka jika tidak nol
+func setOptionalInt(data url.Values, key string, v int) {
+	if v != 0 {
+		data.Set(key, strconv.Itoa(v))
+	}
+}
+
+// VideoConfig berisi parameter sendVideo
+type VideoConfig struct {
+	MediaConfig
+	Video             InputFile // video
+	Duration          int       // duration dalam detik
+	Width             int       // width
+	Height            int       // height
+	SupportsStreaming bool      // supports_streaming
+}
+
+// SendVideo mengirim video (MPEG4); Message.Video hasilnya berisi file_id baru
+func (b *Bot) SendVideo(chatID int64, video InputFile, caption string) (*Message, error) {
+	return b.SendVideoWithConfig(context.Background(), VideoConfig{
+		MediaConfig: MediaConfig{ChatID: chatID, Caption: caption},
+		Video:       video,
+	})
+}
+
+// SendVideoWithConfig mengirim video sesuai VideoConfig
+func (b *Bot) SendVideoWithConfig(ctx context.Context, cfg VideoConfig) (*Message, error) {
+	data, err := cfg.params()
+	if err != nil {
+		return nil, err
+	}
+	setOptionalInt(data, "duration", cfg.Duration)
+	setOptionalInt(data, "width", cfg.Width)
+	setOptionalInt(data, "height", cfg.Height)
+	if cfg.SupportsStreaming {
+		data.Set("supports_streaming", "true")
+	}
+	return b.sendMedia(ctx, "sendVideo", data, "video", cfg.Video)
+}
+
+// AudioConfig berisi parameter sendAudio
+type AudioConfig struct {
+	MediaConfig
+	Audio     InputFile // audio
+	Duration  int       // duration dalam detik
+	Performer string    // performer
+	Title     string    // title
+}
+
+// SendAudio mengirim file musik (MP3/M4A); Message.Audio hasilnya berisi file_id baru
+func (b *Bot) SendAudio(chatID int64, audio InputFile, caption string) (*Message, error) {
+	return b.SendAudioWithConfig(context.Background(), AudioConfig{
+		MediaConfig: MediaConfig{ChatID: chatID, Caption: caption},
+		Audio:       audio,
+	})
+}
+
+// SendAudioWithConfig mengirim audio sesuai AudioConfig
+func (b *Bot) SendAudioWithConfig(ctx context.Context, cfg AudioConfig) (*Message, error) {
+	data, err := cfg.params()
+	if err != nil {
+		return nil, err
+	}
+	setOptionalInt(data, "duration", cfg.Duration)
+	if cfg.Performer != "" {
+		data.Set("performer", cfg.Performer)
+	}
+	if cfg.Title != "" {
+		data.Set("title", cfg.Title)
+	}
+	return b.sendMedia(ctx, "sendAudio", data, "audio", cfg.Audio)
+}
+
+// VoiceConfig berisi parameter sendVoice
+type VoiceConfig struct {
+	MediaConfig
+	Voice    InputFile // voice
+	Duration int       // duration dalam detik
+}
+
+// SendVoice mengirim voice note (OGG/OPUS); Message.Voice hasilnya berisi file_id baru
+func (b *Bot) SendVoice(chatID int64, voice InputFile, caption string) (*Message, error) {
+	return b.SendVoiceWithConfig(context.Background(), VoiceConfig{
+		MediaConfig: MediaConfig{ChatID: chatID, Caption: caption},
+		Voice:       voice,
+	})
+}
+
+// SendVoiceWithConfig mengirim voice note sesuai VoiceConfig
+func (b *Bot) SendVoiceWithConfig(ctx context.Context, cfg VoiceConfig) (*Message, error) {
+	data, err := cfg.params()
+	if err != nil {
+		return nil, err
+	}
+	setOptionalInt(data, "duration", cfg.Duration)
+	return b.sendMedia(ctx, "sendVoice", data, "voice", cfg.Voice)
+}
+
+// AnimationConfig berisi parameter sendAnimation
+type AnimationConfig struct {
+	MediaConfig
+	Animation InputFile // animation
+	Duration  int       // duration dalam detik
+	Width     int       // width
+	Height    int       // height
+}
+
+// SendAnimation mengirim GIF atau video tanpa suara; Message.Animation hasilnya berisi file_id baru
+func (b *Bot) SendAnimation(chatID int64, animation InputFile, caption string) (*Message, error) {
+	return b.SendAnimationWithConfig(context.Background(), AnimationConfig{
+		MediaConfig: MediaConfig{ChatID: chatID, Caption: caption},
+		Animation:   animation,
+	})
+}
+
+// SendAnimationWithConfig mengirim animasi sesuai AnimationConfig
+func (b *Bot) SendAnimationWithConfig(ctx context.Context, cfg AnimationConfig) (*Message, error) {
+	data, err := cfg.params()
+	if err != nil {
+		return nil, err
+	}
+	setOptionalInt(data, "duration", cfg.Duration)
+	setOptionalInt(data, "width", cfg.Width)
+	setOptionalInt(data, "height", cfg.Height)
+	return b.sendMedia(ctx, "sendAnimation", data, "animation", cfg.Animation)
+}
@@ -0,0 +1,113 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// InputMedia adalah satu item album untuk SendMediaGroup: InputMediaPhoto atau InputMediaVideo
+type InputMedia interface {
+	inputFile() InputFile
+	payload(media string) interface{}
+	validate() error
+}
+
+// InputMediaPhoto adalah foto di dalam album
+type InputMediaPhoto struct {
+	Media           InputFile       `json:"-"`
+	Caption         string          `json:"caption,omitempty"`
+	ParseMode       string          `json:"parse_mode,omitempty"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	HasSpoiler      bool            `json:"has_spoiler,omitempty"`
+}
+
+func (p InputMediaPhoto) inputFile() InputFile { return p.Media }
+
+func (p InputMediaPhoto) validate() error { return validateFormatting(p.ParseMode, p.CaptionEntities) }
+
+func (p InputMediaPhoto) payload(media string) interface{} {
+	type plain InputMediaPhoto
+	return struct {
+		Type  string `json:"type"`
+		Media string `json:"media"`
+		plain
+	}{"photo", media, plain(p)}
+}
+
+// InputMediaVideo adalah video di dalam album
+type InputMediaVideo struct {
+	Media             InputFile       `json:"-"`
+	Caption           string          `json:"caption,omitempty"`
+	ParseMode         string          `json:"parse_mode,omitempty"`
+	CaptionEntities   []MessageEntity `json:"caption_entities,omitempty"`
+	Width             int             `json:"width,omitempty"`
+	Height            int             `json:"height,omitempty"`
+	Duration          int             `json:"duration,omitempty"`
+	SupportsStreaming bool            `json:"supports_streaming,omitempty"`
+	HasSpoiler        bool            `json:"has_spoiler,omitempty"`
+}
+
+func (v InputMediaVideo) inputFile() InputFile { return v.Media }
+
+func (v InputMediaVideo) validate() error { return validateFormatting(v.ParseMode, v.CaptionEntities) }
+
+func (v InputMediaVideo) payload(media string) interface{} {
+	type plain InputMediaVideo
+	return struct {
+		Type  string `json:"type"`
+		Media string `json:"media"`
+		plain
+	}{"video", media, plain(v)}
+}
+
+// encodeInputMedia menghasilkan payload JSON media. File yang perlu di-upload dirujuk dengan
+// attach://<field> dan ditambahkan ke files.
+func encodeInputMedia(m InputMedia, field string, files []uploadFile) (interface{}, []uploadFile) {
+	f := m.inputFile()
+	if f.needsUpload() {
+		return m.payload("attach://" + field), append(files, uploadFile{field: field, file: f})
+	}
+	return m.payload(f.value()), files
+}
+
+// SendMediaGroup mengirim 2-10 foto/video sebagai satu album dan mengembalikan semua pesannya
+func (b *Bot) SendMediaGroup(chatID int64, media []InputMedia) ([]Message, error) {
+	return b.SendMediaGroupWithOptions(chatID, media, SendOptions{})
+}
+
+// SendMediaGroupWithOptions sama dengan SendMediaGroup dengan opsi tambahan, misalnya
+// MessageThreadID. sendMediaGroup tidak mendukung reply_markup, jadi opts.ReplyMarkup harus nil.
+func (b *Bot) SendMediaGroupWithOptions(chatID int64, media []InputMedia, opts SendOptions) ([]Message, error) {
+	if opts.ReplyMarkup != nil {
+		return nil, fmt.Errorf("telegram: sendMediaGroup: reply markup is not supported")
+	}
+	if len(media) < 2 || len(media) > 10 {
+		return nil, fmt.Errorf("telegram: sendMediaGroup: need 2-10 media, got %d", len(media))
+	}
+
+	var files []uploadFile
+	payloads := make([]interface{}, len(media))
+	for i, m := range media {
+		if err := m.validate(); err != nil {
+			return nil, err
+		}
+		payloads[i], files = encodeInputMedia(m, "file"+strconv.Itoa(i), files)
+	}
+
+	data := url.Values{}
+	data.Set("chat_id", strconv.FormatInt(chatID, 10))
+	if err := setJSON(data, "media", payloads); err != nil {
+		return nil, err
+	}
+	if err := opts.params(data); err != nil {
+		return nil, err
+	}
+
+	var msgs []Message
+	if err := b.doUpload(context.Background(), "sendMediaGroup", data, files, &msgs); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
@@ -0,0 +1,86 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestMediaCaptionEntities(t *testing.T) {
+	entities := []MessageEntity{{Type: EntityBold, Offset: 0, Length: 4}}
+	var got []MessageEntity
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("ParseForm: %v", err)
+		}
+		if err := json.Unmarshal([]byte(r.PostForm.Get("caption_entities")), &got); err != nil {
+			t.Errorf("caption_entities = %q: %v", r.PostForm.Get("caption_entities"), err)
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	_, err := bot.SendPhotoWithConfig(context.Background(), PhotoConfig{
+		MediaConfig: MediaConfig{ChatID: 1, Caption: "Baru!", CaptionEntities: entities},
+		Photo:       FileID("AgAD"),
+	})
+	if err != nil {
+		t.Fatalf("SendPhotoWithConfig: %v", err)
+	}
+	if !reflect.DeepEqual(got, entities) {
+		t.Errorf("caption_entities = %+v, want %+v", got, entities)
+	}
+
+	_, err = bot.SendPhotoWithConfig(context.Background(), PhotoConfig{
+		MediaConfig: MediaConfig{ChatID: 1, Caption: "x", ParseMode: ParseModeHTML, CaptionEntities: entities},
+		Photo:       FileID("AgAD"),
+	})
+	if err == nil {
+		t.Error("ParseMode with CaptionEntities should fail")
+	}
+}
+
+func TestSendMediaGroupValidatesCaptions(t *testing.T) {
+	entities := []MessageEntity{{Type: EntityBold, Offset: 0, Length: 1}}
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"ok":true,"result":[]}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	tests := []struct {
+		name  string
+		media []InputMedia
+	}{
+		{"photo", []InputMedia{
+			InputMediaPhoto{Media: FileID("a")},
+			InputMediaPhoto{Media: FileID("b"), Caption: "x", ParseMode: ParseModeHTML, CaptionEntities: entities},
+		}},
+		{"video", []InputMedia{
+			InputMediaVideo{Media: FileID("a"), Caption: "x", ParseMode: ParseModeMarkdownV2, CaptionEntities: entities},
+			InputMediaPhoto{Media: FileID("b")},
+		}},
+		{"invalid parse mode", []InputMedia{
+			InputMediaPhoto{Media: FileID("a"), Caption: "x", ParseMode: "BBCode"},
+			InputMediaPhoto{Media: FileID("b")},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := bot.SendMediaGroup(1, tt.media); err == nil {
+				t.Fatal("SendMediaGroup succeeded, want validation error")
+			}
+		})
+	}
+	if calls != 0 {
+		t.Errorf("server received %d requests, want none", calls)
+	}
+}
@@ -0,0 +1,57 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// setChatMenuButtonRequest adalah body JSON setChatMenuButton; chat_id nol tidak dikirim
+type setChatMenuButtonRequest struct {
+	ChatID     int64      `json:"chat_id,omitempty"`
+	MenuButton MenuButton `json:"menu_button,omitempty"`
+}
+
+// SetChatMenuButton mengatur tombol menu bot di private chat. chatID nol mengubah tombol
+// default untuk semua chat; button nil sama dengan MenuButtonDefault.
+func (b *Bot) SetChatMenuButton(chatID int64, button MenuButton) error {
+	req := setChatMenuButtonRequest{ChatID: chatID, MenuButton: button}
+	return b.doJSONRequest(context.Background(), "setChatMenuButton", req, nil)
+}
+
+// getChatMenuButtonRequest adalah body JSON getChatMenuButton
+type getChatMenuButtonRequest struct {
+	ChatID int64 `json:"chat_id,omitempty"`
+}
+
+// GetChatMenuButton mengambil tombol menu bot di private chat; chatID nol mengambil tombol default
+func (b *Bot) GetChatMenuButton(chatID int64) (MenuButton, error) {
+	var raw json.RawMessage
+	if err := b.doJSONRequest(context.Background(), "getChatMenuButton", getChatMenuButtonRequest{ChatID: chatID}, &raw); err != nil {
+		return nil, err
+	}
+	return decodeMenuButton(raw)
+}
+
+// decodeMenuButton memilih tipe MenuButton sesuai field type
+func decodeMenuButton(raw json.RawMessage) (MenuButton, error) {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &probe); err != nil {
+		return nil, fmt.Errorf("telegram: getChatMenuButton: decode result: %w", err)
+	}
+	switch probe.Type {
+	case "commands":
+		return MenuButtonCommands{}, nil
+	case "default":
+		return MenuButtonDefault{}, nil
+	case "web_app":
+		var button MenuButtonWebApp
+		if err := json.Unmarshal(raw, &button); err != nil {
+			return nil, fmt.Errorf("telegram: getChatMenuButton: decode result: %w", err)
+		}
+		return button, nil
+	}
+	return nil, fmt.Errorf("telegram: getChatMenuButton: unknown menu button type %q", probe.Type)
+}
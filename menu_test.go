@@ -0,0 +1,45 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMenuButtonJSON(t *testing.T) {
+	tests := []struct {
+		button MenuButton
+		want   string
+	}{
+		{MenuButtonCommands{}, `{"type":"commands"}`},
+		{MenuButtonDefault{}, `{"type":"default"}`},
+		{MenuButtonWebApp{Text: "Buka", WebApp: WebAppInfo{URL: "https://example.com/app"}}, `{"type":"web_app","text":"Buka","web_app":{"url":"https://example.com/app"}}`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.button)
+		if err != nil {
+			t.Fatalf("Marshal(%T): %v", tt.button, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Marshal(%T) = %s, want %s", tt.button, got, tt.want)
+		}
+		decoded, err := decodeMenuButton(got)
+		if err != nil {
+			t.Fatalf("decodeMenuButton(%s): %v", got, err)
+		}
+		if !reflect.DeepEqual(decoded, tt.button) {
+			t.Errorf("decodeMenuButton(%s) = %#v, want %#v", got, decoded, tt.button)
+		}
+	}
+}
+
+func TestSetChatMenuButtonDefaultChat(t *testing.T) {
+	bot, _, body := captureServer(t, `true`)
+	if err := bot.SetChatMenuButton(0, MenuButtonCommands{}); err != nil {
+		t.Fatalf("SetChatMenuButton: %v", err)
+	}
+	want := map[string]interface{}{"menu_button": map[string]interface{}{"type": "commands"}}
+	if !reflect.DeepEqual(*body, want) {
+		t.Errorf("body = %v, want %v", *body, want)
+	}
+}
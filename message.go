@@ -0,0 +1,191 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Nilai parse_mode yang diterima Telegram
+const (
+	ParseModeMarkdown   = "Markdown"
+	ParseModeMarkdownV2 = "MarkdownV2"
+	ParseModeHTML       = "HTML"
+)
+
+// SendMessageConfig berisi parameter sendMessage. Field yang bernilai nol tidak dikirim.
+type SendMessageConfig struct {
+	ChatID                int64               // chat_id
+	Chat                  ChatID              // chat_id berupa @username; jika diisi, ChatID diabaikan
+	Text                  string              // text
+	ParseMode             string              // parse_mode: ParseModeMarkdownV2, ParseModeMarkdown, atau ParseModeHTML
+	MessageThreadID       int                 // message_thread_id, topik forum tujuan
+	ReplyToMessageID      int                 // reply_to_message_id
+	ReplyParameters       *ReplyParameters    // reply_parameters untuk reply lintas chat/kutipan; menggantikan ReplyToMessageID
+	DisableNotification   bool                // disable_notification
+	DisableWebPagePreview bool                // disable_web_page_preview
+	LinkPreviewOptions    *LinkPreviewOptions // link_preview_options; menggantikan DisableWebPagePreview
+	ProtectContent        bool                // protect_content
+	ReplyMarkup           ReplyMarkup         // reply_markup, di-serialize sebagai JSON
+
+	// Entities dikirim sebagai entities (array JSON) pengganti ParseMode; lihat NewText
+	Entities []MessageEntity
+}
+
+// sendMessageRequest adalah body JSON sendMessage; objek bertingkat di-serialize langsung
+type sendMessageRequest struct {
+	ChatID                string              `json:"chat_id"`
+	MessageThreadID       int                 `json:"message_thread_id,omitempty"`
+	Text                  string              `json:"text"`
+	ParseMode             string              `json:"parse_mode,omitempty"`
+	Entities              []MessageEntity     `json:"entities,omitempty"`
+	LinkPreviewOptions    *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	DisableWebPagePreview bool                `json:"disable_web_page_preview,omitempty"`
+	DisableNotification   bool                `json:"disable_notification,omitempty"`
+	ProtectContent        bool                `json:"protect_content,omitempty"`
+	ReplyToMessageID      int                 `json:"reply_to_message_id,omitempty"`
+	ReplyParameters       *ReplyParameters    `json:"reply_parameters,omitempty"`
+	ReplyMarkup           ReplyMarkup         `json:"reply_markup,omitempty"`
+}
+
+// request memvalidasi config lalu mengubahnya menjadi body JSON sendMessage
+func (c SendMessageConfig) request() (*sendMessageRequest, error) {
+	if err := validateFormatting(c.ParseMode, c.Entities); err != nil {
+		return nil, err
+	}
+
+	req := &sendMessageRequest{
+		ChatID:              chatIDValue(c.ChatID, c.Chat),
+		MessageThreadID:     c.MessageThreadID,
+		Text:                c.Text,
+		ParseMode:           c.ParseMode,
+		Entities:            c.Entities,
+		LinkPreviewOptions:  c.LinkPreviewOptions,
+		DisableNotification: c.DisableNotification,
+		ProtectContent:      c.ProtectContent,
+		ReplyParameters:     c.ReplyParameters,
+		ReplyMarkup:         c.ReplyMarkup,
+	}
+	if c.LinkPreviewOptions == nil {
+		req.DisableWebPagePreview = c.DisableWebPagePreview
+	}
+	if c.ReplyParameters == nil {
+		req.ReplyToMessageID = c.ReplyToMessageID
+	}
+	return req, nil
+}
+
+// SendOptions berisi parameter opsional umum untuk method pengiriman tanpa config sendiri
+// (SendVenueWithOptions, SendDiceWithOptions, ...). Field yang bernilai nol tidak dikirim.
+type SendOptions struct {
+	MessageThreadID     int         `json:"message_thread_id,omitempty"` // topik forum tujuan
+	ReplyToMessageID    int         `json:"reply_to_message_id,omitempty"`
+	DisableNotification bool        `json:"disable_notification,omitempty"`
+	ProtectContent      bool        `json:"protect_content,omitempty"`
+	ReplyMarkup         ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// params menambahkan opsi ke form values untuk method yang bisa meng-upload file
+func (o SendOptions) params(data url.Values) error {
+	setOptionalInt(data, "message_thread_id", o.MessageThreadID)
+	setOptionalInt(data, "reply_to_message_id", o.ReplyToMessageID)
+	if o.DisableNotification {
+		data.Set("disable_notification", "true")
+	}
+	if o.ProtectContent {
+		data.Set("protect_content", "true")
+	}
+	if o.ReplyMarkup != nil {
+		return setJSON(data, "reply_markup", o.ReplyMarkup)
+	}
+	return nil
+}
+
+// validateParseMode memastikan mode kosong atau salah satu nilai parse_mode yang valid
+func validateParseMode(mode string) error {
+	switch mode {
+	case "", ParseModeMarkdown, ParseModeMarkdownV2, ParseModeHTML:
+		return nil
+	}
+	return fmt.Errorf("telegram: invalid parse mode %q", mode)
+}
+
+// validateFormatting memvalidasi parse mode dan memastikan tidak dipakai bersama entities
+func validateFormatting(mode string, entities []MessageEntity) error {
+	if err := validateParseMode(mode); err != nil {
+		return err
+	}
+	if mode != "" && len(entities) > 0 {
+		return fmt.Errorf("telegram: parse mode and entities are mutually exclusive")
+	}
+	return nil
+}
+
+// Send mengirim pesan sesuai SendMessageConfig
+func (b *Bot) Send(cfg SendMessageConfig) (*Message, error) {
+	return b.SendContext(context.Background(), cfg)
+}
+
+// SendContext sama dengan Send tetapi dapat dibatalkan lewat ctx. Request dikirim sebagai
+// body JSON sehingga ReplyMarkup, Entities, dan opsi bertingkat lainnya tidak di-encode dua kali.
+func (b *Bot) SendContext(ctx context.Context, cfg SendMessageConfig) (*Message, error) {
+	req, err := cfg.request()
+	if err != nil {
+		return nil, err
+	}
+
+	var msg Message
+	if err := b.doJSONRequest(ctx, "sendMessage", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// SendMessage mengirim pesan ke chat tertentu dan mengembalikan pesan yang terkirim
+func (b *Bot) SendMessage(chatID int64, text string) (*Message, error) {
+	return b.SendMessageContext(context.Background(), chatID, text)
+}
+
+// SendMessageContext sama dengan SendMessage tetapi dapat dibatalkan lewat ctx
+func (b *Bot) SendMessageContext(ctx context.Context, chatID int64, text string) (*Message, error) {
+	return b.SendContext(ctx, SendMessageConfig{ChatID: chatID, Text: text})
+}
+
+// SendMessageFormatted mengirim pesan berformat dengan parse_mode MarkdownV2, Markdown, atau HTML
+func (b *Bot) SendMessageFormatted(chatID int64, text, parseMode string) (*Message, error) {
+	return b.Send(SendMessageConfig{ChatID: chatID, Text: text, ParseMode: parseMode})
+}
+
+// SendMessageMigrating sama dengan Send, tetapi jika grup sudah di-upgrade menjadi supergroup
+// pesan dikirim ulang ke chat id baru. Chat yang akhirnya dipakai dikembalikan agar pemanggil
+// dapat memperbarui id yang tersimpan; jika pengiriman gagal ke target @username, yang
+// dikembalikan adalah username itu sendiri karena id numeriknya tidak diketahui.
+func (b *Bot) SendMessageMigrating(cfg SendMessageConfig) (*Message, ChatID, error) {
+	msg, err := b.Send(cfg)
+	if newID, migrated := IsChatMigrated(err); migrated {
+		cfg.ChatID = newID
+		cfg.Chat = ChatID{}
+		msg, err = b.Send(cfg)
+	}
+	if msg != nil {
+		return msg, ChatIDInt(msg.Chat.ID), err
+	}
+	if !cfg.Chat.IsZero() {
+		return msg, cfg.Chat, err
+	}
+	return msg, ChatIDInt(cfg.ChatID), err
+}
+
+// LargestPhoto mengembalikan ukuran foto dengan resolusi tertinggi, atau nil jika pesan tidak
+// berisi foto. file_id-nya bisa langsung dipakai untuk DownloadFileByID.
+func (m *Message) LargestPhoto() *PhotoSize {
+	var largest *PhotoSize
+	for i := range m.Photo {
+		p := &m.Photo[i]
+		if largest == nil || p.Width*p.Height > largest.Width*largest.Height ||
+			(p.Width*p.Height == largest.Width*largest.Height && p.FileSize > largest.FileSize) {
+			largest = p
+		}
+	}
+	return largest
+}
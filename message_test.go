@@ -0,0 +1,157 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestLargestPhoto(t *testing.T) {
+	payload := `{
+		"message_id": 1,
+		"chat": {"id": 1, "type": "private"},
+		"photo": [
+			{"file_id": "small", "file_unique_id": "s", "width": 90, "height": 60, "file_size": 1200},
+			{"file_id": "large-a", "file_unique_id": "a", "width": 1280, "height": 853, "file_size": 98000},
+			{"file_id": "medium", "file_unique_id": "m", "width": 320, "height": 213, "file_size": 14000},
+			{"file_id": "large-b", "file_unique_id": "b", "width": 853, "height": 1280, "file_size": 99000}
+		]
+	}`
+	var msg Message
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(msg.Photo) != 4 {
+		t.Fatalf("len(Photo) = %d, want 4", len(msg.Photo))
+	}
+
+	// large-a dan large-b beresolusi sama; file_size yang lebih besar menang
+	largest := msg.LargestPhoto()
+	if largest == nil || largest.FileID != "large-b" {
+		t.Fatalf("LargestPhoto() = %+v, want large-b", largest)
+	}
+	if largest != &msg.Photo[3] {
+		t.Error("LargestPhoto() should point into Message.Photo")
+	}
+
+	text := &Message{Text: "no photo"}
+	if p := text.LargestPhoto(); p != nil {
+		t.Fatalf("LargestPhoto() on text message = %+v, want nil", p)
+	}
+}
+
+func TestLinkPreviewOptionsJSON(t *testing.T) {
+	opts := LinkPreviewOptions{URL: "https://go.dev", PreferLargeMedia: true, ShowAboveText: true}
+	got, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `{"url":"https://go.dev","prefer_large_media":true,"show_above_text":true}`
+	if string(got) != want {
+		t.Errorf("LinkPreviewOptions JSON = %s, want %s", got, want)
+	}
+
+	got, _ = json.Marshal(LinkPreviewOptions{IsDisabled: true})
+	if string(got) != `{"is_disabled":true}` {
+		t.Errorf("disabled LinkPreviewOptions JSON = %s", got)
+	}
+}
+
+func TestSendMessageLinkPreviewPreferred(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  SendMessageConfig
+		want map[string]interface{}
+	}{
+		{
+			name: "struct wins over bool",
+			cfg: SendMessageConfig{
+				ChatID:                1,
+				Text:                  "https://go.dev",
+				DisableWebPagePreview: true,
+				LinkPreviewOptions:    &LinkPreviewOptions{PreferSmallMedia: true},
+			},
+			want: map[string]interface{}{
+				"chat_id":              "1",
+				"text":                 "https://go.dev",
+				"link_preview_options": map[string]interface{}{"prefer_small_media": true},
+			},
+		},
+		{
+			name: "bool only",
+			cfg:  SendMessageConfig{ChatID: 1, Text: "x", DisableWebPagePreview: true},
+			want: map[string]interface{}{
+				"chat_id":                  "1",
+				"text":                     "x",
+				"disable_web_page_preview": true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.cfg.request()
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			encoded, _ := json.Marshal(req)
+			var got map[string]interface{}
+			if err := json.Unmarshal(encoded, &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("body = %s, want %v", encoded, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendMessageMigratingClearsChat(t *testing.T) {
+	var chatIDs []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		chatID, _ := body["chat_id"].(string)
+		chatIDs = append(chatIDs, chatID)
+		if chatID != "-1002" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1002}}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":5,"chat":{"id":-1002,"type":"supergroup"}}}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	msg, usedID, err := bot.SendMessageMigrating(SendMessageConfig{Chat: ChatIDInt(-1), Text: "hi"})
+	if err != nil {
+		t.Fatalf("SendMessageMigrating: %v", err)
+	}
+	if msg.MessageID != 5 || usedID != ChatIDInt(-1002) {
+		t.Fatalf("message %d sent to %v, want 5 to -1002", msg.MessageID, usedID)
+	}
+	if want := []string{"-1", "-1002"}; !reflect.DeepEqual(chatIDs, want) {
+		t.Errorf("chat_id sequence = %q, want %q", chatIDs, want)
+	}
+}
+
+func TestSendMessageMigratingUsernameFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	_, used, err := bot.SendMessageMigrating(SendMessageConfig{Chat: ChatUsername("kanal"), Text: "hi"})
+	if err == nil {
+		t.Fatal("SendMessageMigrating succeeded, want error")
+	}
+	// id numerik tidak diketahui, jadi username dikembalikan apa adanya dan bukan id 0
+	if used != ChatUsername("kanal") {
+		t.Errorf("used chat = %+v, want @kanal", used)
+	}
+}
@@ -0,0 +1,105 @@
+package telegrambot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// BanOptions berisi parameter opsional banChatMember
+type BanOptions struct {
+	UntilDate      time.Time // until_date; nol berarti diblokir selamanya
+	RevokeMessages bool      // revoke_messages, hapus semua pesan user dari chat
+}
+
+// setUntilDate menambahkan until_date jika t tidak nol
+func setUntilDate(data url.Values, t time.Time) {
+	if !t.IsZero() {
+		data.Set("until_date", strconv.FormatInt(t.Unix(), 10))
+	}
+}
+
+// BanChatMember mengeluarkan dan memblokir user dari grup, supergroup, atau channel
+func (b *Bot) BanChatMember(chatID int64, userID int, opts BanOptions) error {
+	data := chatUserParams(chatID, userID)
+	setUntilDate(data, opts.UntilDate)
+	if opts.RevokeMessages {
+		data.Set("revoke_messages", "true")
+	}
+	return b.doRequest(context.Background(), "banChatMember", data, nil)
+}
+
+// UnbanChatMember membuka blokir user. Jika onlyIfBanned false, user yang masih menjadi
+// anggota juga akan dikeluarkan dari chat.
+func (b *Bot) UnbanChatMember(chatID int64, userID int, onlyIfBanned bool) error {
+	data := chatUserParams(chatID, userID)
+	if onlyIfBanned {
+		data.Set("only_if_banned", "true")
+	}
+	return b.doRequest(context.Background(), "unbanChatMember", data, nil)
+}
+
+// unixTime mengubah t menjadi Unix time; waktu nol menjadi 0 sehingga tidak dikirim
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// restrictChatMemberRequest adalah body JSON restrictChatMember
+type restrictChatMemberRequest struct {
+	ChatID      int64           `json:"chat_id"`
+	UserID      int             `json:"user_id"`
+	Permissions ChatPermissions `json:"permissions"`
+	UntilDate   int64           `json:"until_date,omitempty"`
+}
+
+// RestrictChatMember membatasi izin user di supergroup sampai waktu until (nol berarti selamanya)
+func (b *Bot) RestrictChatMember(chatID int64, userID int, perms ChatPermissions, until time.Time) error {
+	req := restrictChatMemberRequest{ChatID: chatID, UserID: userID, Permissions: perms, UntilDate: unixTime(until)}
+	return b.doJSONRequest(context.Background(), "restrictChatMember", req, nil)
+}
+
+// promoteChatMemberRequest adalah body JSON promoteChatMember; semua hak dikirim, termasuk yang false
+type promoteChatMemberRequest struct {
+	ChatID int64 `json:"chat_id"`
+	UserID int   `json:"user_id"`
+	ChatAdminRights
+}
+
+// PromoteChatMember mengatur hak admin user. Semua hak bernilai false menurunkan user
+// kembali menjadi anggota biasa.
+func (b *Bot) PromoteChatMember(chatID int64, userID int, rights ChatAdminRights) error {
+	req := promoteChatMemberRequest{ChatID: chatID, UserID: userID, ChatAdminRights: rights}
+	return b.doJSONRequest(context.Background(), "promoteChatMember", req, nil)
+}
+
+// SetChatAdministratorCustomTitle mengatur gelar kustom admin yang dipromosikan oleh bot
+func (b *Bot) SetChatAdministratorCustomTitle(chatID int64, userID int, title string) error {
+	data := chatUserParams(chatID, userID)
+	data.Set("custom_title", title)
+	return b.doRequest(context.Background(), "setChatAdministratorCustomTitle", data, nil)
+}
+
+// ChatPermissionsOptions berisi parameter opsional setChatPermissions
+type ChatPermissionsOptions struct {
+	// UseIndependentChatPermissions membuat setiap izin media berdiri sendiri; jika false,
+	// can_send_other_messages dan can_add_web_page_previews ikut mengaktifkan izin lain
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+}
+
+// setChatPermissionsRequest adalah body JSON setChatPermissions
+type setChatPermissionsRequest struct {
+	ChatID      int64           `json:"chat_id"`
+	Permissions ChatPermissions `json:"permissions"`
+	ChatPermissionsOptions
+}
+
+// SetChatPermissions mengatur izin default semua anggota grup atau supergroup, misalnya
+// ChatPermissions nol untuk mode baca saja. Bot harus admin dengan hak can_restrict_members.
+func (b *Bot) SetChatPermissions(chatID int64, perms ChatPermissions, opts ChatPermissionsOptions) error {
+	req := setChatPermissionsRequest{ChatID: chatID, Permissions: perms, ChatPermissionsOptions: opts}
+	return b.doJSONRequest(context.Background(), "setChatPermissions", req, nil)
+}
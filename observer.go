@@ -0,0 +1,42 @@
+package telegrambot
+
+import "time"
+
+// Observer menerima hasil setiap percobaan request ke API, termasuk percobaan yang diulang
+// karena 429, sehingga jumlah request, latensi, error dan retry per method bisa dicatat
+// tanpa package ini bergantung pada library metrics tertentu.
+//
+// Contoh dengan Prometheus:
+//
+//	type promObserver struct {
+//		requests *prometheus.CounterVec   // label: method, result
+//		latency  *prometheus.HistogramVec // label: method
+//	}
+//
+//	func (o promObserver) ObserveRequest(method string, d time.Duration, err error) {
+//		result := "ok"
+//		if err != nil {
+//			result = "error"
+//		}
+//		o.requests.WithLabelValues(method, result).Inc()
+//		o.latency.WithLabelValues(method).Observe(d.Seconds())
+//	}
+//
+//	bot.SetObserver(promObserver{requests: requests, latency: latency})
+type Observer interface {
+	ObserveRequest(method string, duration time.Duration, err error)
+}
+
+// SetObserver memasang Observer untuk bot; nil menonaktifkannya. Panggil sebelum bot mulai
+// mengirim request. ObserveRequest dipanggil secara sinkron, jadi jangan melakukan kerja berat di sana.
+func (b *Bot) SetObserver(observer Observer) {
+	b.observer = observer
+}
+
+// observe melaporkan satu percobaan request yang dimulai pada start
+func (b *Bot) observe(method string, start time.Time, err error) {
+	if b.observer == nil {
+		return
+	}
+	b.observer.ObserveRequest(method, time.Since(start), err)
+}
@@ -0,0 +1,102 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+)
+
+// InvoiceConfig berisi parameter sendInvoice. Title, Description, Payload, Currency, dan
+// Prices wajib diisi; ProviderToken boleh kosong untuk pembayaran Telegram Stars (XTR).
+type InvoiceConfig struct {
+	Title         string         `json:"title"`       // 1-32 karakter
+	Description   string         `json:"description"` // 1-255 karakter
+	Payload       string         `json:"payload"`     // 1-128 byte, tidak ditampilkan ke user
+	ProviderToken string         `json:"provider_token,omitempty"`
+	Currency      string         `json:"currency"` // kode mata uang ISO 4217
+	Prices        []LabeledPrice `json:"prices"`
+
+	MaxTipAmount        int    `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts []int  `json:"suggested_tip_amounts,omitempty"`
+	StartParameter      string `json:"start_parameter,omitempty"`
+	ProviderData        string `json:"provider_data,omitempty"` // JSON untuk payment provider
+	PhotoURL            string `json:"photo_url,omitempty"`
+	NeedName            bool   `json:"need_name,omitempty"`
+	NeedPhoneNumber     bool   `json:"need_phone_number,omitempty"`
+	NeedEmail           bool   `json:"need_email,omitempty"`
+	NeedShippingAddress bool   `json:"need_shipping_address,omitempty"`
+	IsFlexible          bool   `json:"is_flexible,omitempty"` // harga bergantung pada pengiriman, memicu ShippingQuery
+
+	MessageThreadID     int                   `json:"message_thread_id,omitempty"`
+	DisableNotification bool                  `json:"disable_notification,omitempty"`
+	ProtectContent      bool                  `json:"protect_content,omitempty"`
+	ReplyToMessageID    int                   `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // tombol pertama harus tombol Pay
+}
+
+// sendInvoiceRequest adalah body JSON sendInvoice
+type sendInvoiceRequest struct {
+	ChatID int64 `json:"chat_id"`
+	InvoiceConfig
+}
+
+// SendInvoice mengirim tagihan yang dapat dibayar langsung dari chat
+func (b *Bot) SendInvoice(chatID int64, invoice InvoiceConfig) (*Message, error) {
+	if invoice.Title == "" || invoice.Description == "" || invoice.Payload == "" || invoice.Currency == "" {
+		return nil, fmt.Errorf("telegram: sendInvoice: title, description, payload and currency are required")
+	}
+	if len(invoice.Prices) == 0 {
+		return nil, fmt.Errorf("telegram: sendInvoice: at least one price is required")
+	}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "sendInvoice", sendInvoiceRequest{ChatID: chatID, InvoiceConfig: invoice}, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// answerPreCheckoutQueryRequest adalah body JSON answerPreCheckoutQuery
+type answerPreCheckoutQueryRequest struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	OK                 bool   `json:"ok"`
+	ErrorMessage       string `json:"error_message,omitempty"`
+}
+
+// AnswerPreCheckoutQuery mengonfirmasi atau menolak pembayaran. Jawaban harus dikirim dalam
+// 10 detik; jika ok false, errorMessage wajib diisi dan ditampilkan ke user.
+func (b *Bot) AnswerPreCheckoutQuery(id string, ok bool, errorMessage string) error {
+	if !ok && errorMessage == "" {
+		return fmt.Errorf("telegram: answerPreCheckoutQuery: errorMessage is required when ok is false")
+	}
+	req := answerPreCheckoutQueryRequest{PreCheckoutQueryID: id, OK: ok}
+	if !ok {
+		req.ErrorMessage = errorMessage
+	}
+	return b.doJSONRequest(context.Background(), "answerPreCheckoutQuery", req, nil)
+}
+
+// answerShippingQueryRequest adalah body JSON answerShippingQuery
+type answerShippingQueryRequest struct {
+	ShippingQueryID string           `json:"shipping_query_id"`
+	OK              bool             `json:"ok"`
+	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"`
+	ErrorMessage    string           `json:"error_message,omitempty"`
+}
+
+// AnswerShippingQuery menjawab ShippingQuery dari invoice dengan IsFlexible. Jika ok true,
+// options wajib berisi minimal satu opsi; jika false, errorMessage wajib diisi.
+func (b *Bot) AnswerShippingQuery(id string, ok bool, options []ShippingOption, errorMessage string) error {
+	req := answerShippingQueryRequest{ShippingQueryID: id, OK: ok}
+	if ok {
+		if len(options) == 0 {
+			return fmt.Errorf("telegram: answerShippingQuery: shipping options are required when ok is true")
+		}
+		req.ShippingOptions = options
+	} else {
+		if errorMessage == "" {
+			return fmt.Errorf("telegram: answerShippingQuery: errorMessage is required when ok is false")
+		}
+		req.ErrorMessage = errorMessage
+	}
+	return b.doJSONRequest(context.Background(), "answerShippingQuery", req, nil)
+}
@@ -0,0 +1,59 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPaymentUpdates(t *testing.T) {
+	payload := `{
+		"update_id": 1,
+		"pre_checkout_query": {
+			"id": "pcq", "from": {"id": 7, "first_name": "A"},
+			"currency": "USD", "total_amount": 1500, "invoice_payload": "order-1",
+			"order_info": {"name": "A", "shipping_address": {"country_code": "ID", "city": "Bandung"}}
+		}
+	}`
+	var u Update
+	if err := json.Unmarshal([]byte(payload), &u); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	q := u.PreCheckoutQuery
+	if q == nil || q.ID != "pcq" || q.TotalAmount != 1500 || q.InvoicePayload != "order-1" {
+		t.Fatalf("PreCheckoutQuery = %+v", q)
+	}
+	if q.OrderInfo == nil || q.OrderInfo.ShippingAddress == nil || q.OrderInfo.ShippingAddress.City != "Bandung" {
+		t.Errorf("OrderInfo = %+v", q.OrderInfo)
+	}
+
+	var m Message
+	err := json.Unmarshal([]byte(`{"message_id": 2, "successful_payment": {"currency": "USD", "total_amount": 1500, "invoice_payload": "order-1", "telegram_payment_charge_id": "tg", "provider_payment_charge_id": "pv"}}`), &m)
+	if err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if m.SuccessfulPayment == nil || m.SuccessfulPayment.TelegramPaymentChargeID != "tg" {
+		t.Errorf("SuccessfulPayment = %+v", m.SuccessfulPayment)
+	}
+}
+
+func TestSendInvoiceBody(t *testing.T) {
+	bot, _, body := captureServer(t, `{"message_id":1,"chat":{"id":1,"type":"private"}}`)
+	_, err := bot.SendInvoice(1, InvoiceConfig{
+		Title: "Kopi", Description: "Satu gelas", Payload: "order-1", ProviderToken: "prov", Currency: "IDR",
+		Prices: []LabeledPrice{{Label: "Kopi", Amount: 2500000}},
+	})
+	if err != nil {
+		t.Fatalf("SendInvoice: %v", err)
+	}
+	prices, ok := (*body)["prices"].([]interface{})
+	if !ok || len(prices) != 1 || (*body)["currency"] != "IDR" || (*body)["provider_token"] != "prov" {
+		t.Errorf("body = %v", *body)
+	}
+
+	if _, err := bot.SendInvoice(1, InvoiceConfig{Title: "x", Description: "x", Payload: "x", Currency: "USD"}); err == nil {
+		t.Error("SendInvoice without prices should fail")
+	}
+	if err := bot.AnswerPreCheckoutQuery("pcq", false, ""); err == nil {
+		t.Error("AnswerPreCheckoutQuery(ok=false) without message should fail")
+	}
+}
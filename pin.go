@@ -0,0 +1,30 @@
+package telegrambot
+
+import (
+	"context"
+	"strconv"
+)
+
+// PinChatMessage menyematkan pesan. Bot harus admin dengan hak can_pin_messages (atau
+// can_edit_messages di channel); jika tidak, *APIError 400 "not enough rights" dikembalikan.
+func (b *Bot) PinChatMessage(chatID int64, messageID int, disableNotification bool) error {
+	data := chatMessageParams(chatID, messageID)
+	if disableNotification {
+		data.Set("disable_notification", "true")
+	}
+	return b.doRequest(context.Background(), "pinChatMessage", data, nil)
+}
+
+// UnpinChatMessage melepas sematan pesan; messageID nol melepas pesan tersemat terbaru
+func (b *Bot) UnpinChatMessage(chatID int64, messageID int) error {
+	data := chatParams(chatID)
+	if messageID != 0 {
+		data.Set("message_id", strconv.Itoa(messageID))
+	}
+	return b.doRequest(context.Background(), "unpinChatMessage", data, nil)
+}
+
+// UnpinAllChatMessages melepas semua pesan tersemat di chat
+func (b *Bot) UnpinAllChatMessages(chatID int64) error {
+	return b.doRequest(context.Background(), "unpinAllChatMessages", chatParams(chatID), nil)
+}
@@ -0,0 +1,243 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	// updatesBufferSize adalah kapasitas channel update dari UpdatesChannel
+	updatesBufferSize = 100
+	// pollRetryDelay adalah jeda awal sebelum mencoba lagi setelah polling gagal
+	pollRetryDelay = time.Second
+	// pollMaxRetryDelay adalah batas atas backoff polling
+	pollMaxRetryDelay = 30 * time.Second
+)
+
+// UpdatesChannel menjalankan long polling di goroutine dan mengirim setiap Update ke channel.
+// Offset dimajukan otomatis; channel ditutup ketika ctx dibatalkan. Error sementara dicatat
+// lewat Logger (lihat SetLogger) dan polling dilanjutkan dengan backoff; error fatal seperti
+// 401 (token tidak valid) menghentikan polling dan menutup channel.
+func (b *Bot) UpdatesChannel(ctx context.Context, timeout int) (<-chan Update, error) {
+	updates, _, err := b.startPolling(ctx, UpdateConfig{Timeout: timeout}, false)
+	return updates, err
+}
+
+// UpdatesChannelWithErrors sama dengan UpdatesChannel tetapi error polling dikirim ke channel
+// kedua. Error dibuang jika channel tersebut penuh sehingga polling tidak pernah terblokir,
+// kecuali error fatal yang selalu dikirim sebagai error terakhir sebelum kedua channel ditutup.
+func (b *Bot) UpdatesChannelWithErrors(ctx context.Context, timeout int) (<-chan Update, <-chan error, error) {
+	return b.startPolling(ctx, UpdateConfig{Timeout: timeout}, true)
+}
+
+// UpdatesChannelWithConfig sama dengan UpdatesChannelWithErrors dengan Limit dan AllowedUpdates
+// dari cfg. cfg.Offset dipakai sebagai offset awal.
+func (b *Bot) UpdatesChannelWithConfig(ctx context.Context, cfg UpdateConfig) (<-chan Update, <-chan error, error) {
+	return b.startPolling(ctx, cfg, true)
+}
+
+// PollingOptions berisi parameter StartPolling
+type PollingOptions struct {
+	Timeout            int      // timeout long polling dalam detik
+	AllowedUpdates     []string // jenis update yang diterima, lihat konstanta UpdateType...
+	DropPendingUpdates bool     // buang update yang menumpuk saat webhook dihapus
+	// KeepWebhook mematikan penghapusan webhook otomatis; jika webhook masih aktif, polling
+	// berhenti dengan error yang memenuhi errors.Is(err, ErrWebhookActive)
+	KeepWebhook bool
+}
+
+// StartPolling menghapus webhook (kecuali KeepWebhook) lalu menjalankan long polling seperti
+// UpdatesChannelWithErrors, sehingga bot yang pindah dari webhook tidak terkena 409 Conflict.
+func (b *Bot) StartPolling(ctx context.Context, opts PollingOptions) (<-chan Update, <-chan error, error) {
+	if err := checkPollTimeout(opts.Timeout); err != nil {
+		return nil, nil, err
+	}
+	if !opts.KeepWebhook {
+		if err := b.deleteWebhook(ctx, opts.DropPendingUpdates); err != nil {
+			return nil, nil, err
+		}
+	}
+	return b.startPolling(ctx, UpdateConfig{Timeout: opts.Timeout, AllowedUpdates: opts.AllowedUpdates}, true)
+}
+
+// startPolling memvalidasi parameter lalu menjalankan pollLoop
+func (b *Bot) startPolling(ctx context.Context, cfg UpdateConfig, withErrors bool) (<-chan Update, <-chan error, error) {
+	if err := checkPollTimeout(cfg.Timeout); err != nil {
+		return nil, nil, err
+	}
+
+	updates := make(chan Update, updatesBufferSize)
+	var errs chan error
+	if withErrors {
+		errs = make(chan error, updatesBufferSize)
+	}
+	go func() {
+		if err := b.pollLoop(ctx, cfg, updates, errs); err != nil && errs != nil {
+			sendFatalError(errs, err)
+		}
+		close(updates)
+		if errs != nil {
+			close(errs)
+		}
+	}()
+	return updates, errs, nil
+}
+
+// checkPollTimeout memvalidasi timeout long polling
+func checkPollTimeout(timeout int) error {
+	if timeout < 0 {
+		return fmt.Errorf("telegram: invalid polling timeout %d", timeout)
+	}
+	return nil
+}
+
+// pollLoop memanggil getUpdates berulang kali sampai ctx dibatalkan. Error sementara
+// (jaringan, 5xx, 429) diulang dengan exponential backoff; error fatal seperti token tidak
+// valid menghentikan loop dan dikembalikan.
+func (b *Bot) pollLoop(ctx context.Context, cfg UpdateConfig, updates chan<- Update, errs chan<- error) error {
+	failures := 0
+	for ctx.Err() == nil {
+		batch, err := b.GetUpdatesWithConfig(ctx, cfg)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if isFatalPollError(err) {
+				b.errorf("telegram: polling stopped: %v", err)
+				return err
+			}
+			b.reportPollError(errs, err)
+			failures++
+			if !sleepContext(ctx, pollBackoff(failures, err)) {
+				return nil
+			}
+			continue
+		}
+		failures = 0
+
+		for _, u := range batch {
+			if u.UpdateID >= cfg.Offset {
+				cfg.Offset = u.UpdateID + 1
+			}
+			select {
+			case updates <- u:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// isFatalPollError melaporkan apakah err tidak akan hilang dengan mencoba lagi:
+// 401 (token tidak valid), 404 (format token salah), atau 409 karena webhook masih aktif
+func isFatalPollError(err error) bool {
+	if IsUnauthorized(err) || errors.Is(err, ErrWebhookActive) {
+		return true
+	}
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.Code == http.StatusNotFound
+}
+
+// pollBackoff menghitung jeda setelah failures kegagalan berturut-turut: berlipat dua dari
+// pollRetryDelay hingga pollMaxRetryDelay dengan jitter acak, dan tidak kurang dari retry_after
+func pollBackoff(failures int, err error) time.Duration {
+	d := pollMaxRetryDelay
+	if failures < 16 {
+		if exp := pollRetryDelay << uint(failures-1); exp < d {
+			d = exp
+		}
+	}
+	d = d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+	if retryAfter, ok := IsRateLimited(err); ok && retryAfter > d {
+		d = retryAfter
+	}
+	return d
+}
+
+// reportPollError mencatat error polling lalu mengirimnya ke errs (jika ada) tanpa memblokir
+func (b *Bot) reportPollError(errs chan<- error, err error) {
+	b.errorf("telegram: polling error: %v", err)
+	if errs == nil {
+		return
+	}
+	select {
+	case errs <- err:
+	default:
+	}
+}
+
+// sendFatalError mengirim error terakhir ke errs; jika penuh, error terlama dibuang
+// agar error fatal selalu sampai ke pemanggil
+func sendFatalError(errs chan error, err error) {
+	for {
+		select {
+		case errs <- err:
+			return
+		default:
+		}
+		select {
+		case <-errs:
+		default:
+		}
+	}
+}
+
+// sleepContext menunggu selama d; mengembalikan false jika ctx dibatalkan lebih dulu
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// UpdatesPoller mengambil update satu per satu dan mengelola offset secara otomatis.
+// Offset hanya dimajukan melewati update yang sudah dikembalikan oleh Next, sehingga
+// update yang belum diproses tidak ikut dikonfirmasi ke Telegram. Tidak aman dipakai
+// bersamaan dari beberapa goroutine.
+type UpdatesPoller struct {
+	bot    *Bot
+	cfg    UpdateConfig
+	buffer []Update
+}
+
+// NewUpdatesPoller membuat UpdatesPoller; cfg.Offset dipakai sebagai offset awal
+func NewUpdatesPoller(bot *Bot, cfg UpdateConfig) *UpdatesPoller {
+	return &UpdatesPoller{bot: bot, cfg: cfg}
+}
+
+// Next mengembalikan update berikutnya, mengambil batch baru dari getUpdates bila buffer kosong
+func (p *UpdatesPoller) Next(ctx context.Context) (Update, error) {
+	for len(p.buffer) == 0 {
+		if err := ctx.Err(); err != nil {
+			return Update{}, err
+		}
+		batch, err := p.bot.GetUpdatesWithConfig(ctx, p.cfg)
+		if err != nil {
+			return Update{}, err
+		}
+		for _, u := range batch {
+			if u.UpdateID >= p.cfg.Offset {
+				p.buffer = append(p.buffer, u)
+			}
+		}
+	}
+
+	u := p.buffer[0]
+	p.buffer = p.buffer[1:]
+	p.cfg.Offset = u.UpdateID + 1
+	return u, nil
+}
+
+// Offset mengembalikan offset berikutnya, dapat disimpan untuk melanjutkan setelah restart
+func (p *UpdatesPoller) Offset() int {
+	return p.cfg.Offset
+}
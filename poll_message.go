@@ -0,0 +1,71 @@
+package telegrambot
+
+import (
+	"context"
+	"fmt"
+)
+
+// Jenis poll untuk PollConfig.Type
+const (
+	PollTypeRegular = "regular"
+	PollTypeQuiz    = "quiz"
+)
+
+// PollConfig berisi parameter opsional sendPoll. Field yang bernilai nol tidak dikirim.
+type PollConfig struct {
+	IsAnonymous           *bool       `json:"is_anonymous,omitempty"` // nil memakai default Telegram (true)
+	Type                  string      `json:"type,omitempty"`         // PollTypeRegular atau PollTypeQuiz
+	AllowsMultipleAnswers bool        `json:"allows_multiple_answers,omitempty"`
+	CorrectOptionID       *int        `json:"correct_option_id,omitempty"` // wajib untuk quiz
+	Explanation           string      `json:"explanation,omitempty"`       // untuk quiz
+	OpenPeriod            int         `json:"open_period,omitempty"`       // dalam detik (5-600)
+	DisableNotification   bool        `json:"disable_notification,omitempty"`
+	ProtectContent        bool        `json:"protect_content,omitempty"`
+	ReplyToMessageID      int         `json:"reply_to_message_id,omitempty"`
+	MessageThreadID       int         `json:"message_thread_id,omitempty"` // topik forum tujuan
+	ReplyMarkup           ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// inputPollOption adalah satu opsi jawaban yang dikirim ke sendPoll
+type inputPollOption struct {
+	Text string `json:"text"`
+}
+
+// sendPollRequest adalah body JSON sendPoll
+type sendPollRequest struct {
+	ChatID   int64             `json:"chat_id"`
+	Question string            `json:"question"`
+	Options  []inputPollOption `json:"options"`
+	PollConfig
+}
+
+// SendPoll mengirim poll dengan 2-10 opsi jawaban
+func (b *Bot) SendPoll(chatID int64, question string, options []string, cfg PollConfig) (*Message, error) {
+	if len(options) < 2 || len(options) > 10 {
+		return nil, fmt.Errorf("telegram: sendPoll: need 2-10 options, got %d", len(options))
+	}
+	if cfg.Type == PollTypeQuiz && cfg.CorrectOptionID == nil {
+		return nil, fmt.Errorf("telegram: sendPoll: quiz requires CorrectOptionID")
+	}
+
+	req := sendPollRequest{ChatID: chatID, Question: question, PollConfig: cfg}
+	req.Options = make([]inputPollOption, len(options))
+	for i, o := range options {
+		req.Options[i] = inputPollOption{Text: o}
+	}
+
+	var msg Message
+	if err := b.doJSONRequest(context.Background(), "sendPoll", req, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// StopPoll menutup poll yang dikirim bot dan mengembalikan hasil akhirnya
+func (b *Bot) StopPoll(chatID int64, messageID int) (*Poll, error) {
+	var poll Poll
+	if err := b.doRequest(context.Background(), "stopPoll", chatMessageParams(chatID, messageID), &poll); err != nil {
+		return nil, err
+	}
+	return &poll, nil
+}
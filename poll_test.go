@@ -0,0 +1,88 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookServer mensimulasikan bot dengan webhook aktif: getUpdates dibalas 409 sampai deleteWebhook dipanggil
+func webhookServer(t *testing.T) (*httptest.Server, *[]string) {
+	var mu sync.Mutex
+	var calls []string
+	active := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		mu.Lock()
+		calls = append(calls, method)
+		mu.Unlock()
+		switch method {
+		case "deleteWebhook":
+			mu.Lock()
+			active = false
+			mu.Unlock()
+			w.Write([]byte(`{"ok":true,"result":true}`))
+		case "getUpdates":
+			mu.Lock()
+			conflict := active
+			mu.Unlock()
+			if conflict {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"ok":false,"error_code":409,"description":"Conflict: can't use getUpdates method while webhook is active; use deleteWebhook to delete the webhook first"}`))
+				return
+			}
+			w.Write([]byte(`{"ok":true,"result":[{"update_id":1,"message":{"message_id":1,"chat":{"id":1,"type":"private"},"text":"hai"}}]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestStartPollingDeletesWebhook(t *testing.T) {
+	srv, calls := webhookServer(t)
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	updates, _, err := bot.StartPolling(ctx, PollingOptions{})
+	if err != nil {
+		t.Fatalf("StartPolling: %v", err)
+	}
+	u, ok := <-updates
+	if !ok || u.Message.Text != "hai" {
+		t.Fatalf("update = %+v, %v", u, ok)
+	}
+	cancel()
+	if (*calls)[0] != "deleteWebhook" {
+		t.Errorf("calls = %v, want deleteWebhook first", *calls)
+	}
+}
+
+func TestStartPollingKeepWebhook(t *testing.T) {
+	srv, _ := webhookServer(t)
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	updates, errs, err := bot.StartPolling(ctx, PollingOptions{KeepWebhook: true})
+	if err != nil {
+		t.Fatalf("StartPolling: %v", err)
+	}
+	var last error
+	for err := range errs {
+		last = err
+	}
+	if !errors.Is(last, ErrWebhookActive) {
+		t.Fatalf("last error = %v, want ErrWebhookActive", last)
+	}
+	if _, ok := <-updates; ok {
+		t.Error("updates channel should be closed after ErrWebhookActive")
+	}
+}
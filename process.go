@@ -0,0 +1,98 @@
+package telegrambot
+
+import (
+	"context"
+	"runtime/debug"
+)
+
+// dedupWindow adalah jumlah update_id terakhir yang diingat ProcessUpdates untuk membuang duplikat
+const dedupWindow = 1000
+
+// ProcessUpdates menjalankan long polling dan memanggil handler untuk setiap update secara
+// berurutan sampai ctx dibatalkan. Dengan WithWorkers handler dijalankan paralel, tetapi
+// update dari chat yang sama tetap berurutan kecuali WithPerChatOrdering(false). Panic di
+// handler dipulihkan dan dicatat lewat Logger, lalu pemrosesan dilanjutkan; update dengan
+// update_id yang sudah diproses dilewati.
+// Error fatal polling (misalnya 401) menghentikan pemrosesan dan dikembalikan; selain itu
+// hasilnya adalah ctx.Err().
+//
+//	err := bot.ProcessUpdates(ctx, 30, func(u telegrambot.Update) {
+//		if msg := u.EffectiveMessage(); msg != nil {
+//			bot.SendMessage(msg.Chat.ID, msg.Text)
+//		}
+//	}, telegrambot.WithWorkers(8))
+//
+// ProcessUpdates baru kembali setelah semua update yang sudah diterima selesai diproses.
+func (b *Bot) ProcessUpdates(ctx context.Context, timeout int, handler func(Update), opts ...ProcessOption) error {
+	if err := checkPollTimeout(timeout); err != nil {
+		return err
+	}
+
+	updates := make(chan Update, updatesBufferSize)
+	var pollErr error
+	go func() {
+		pollErr = b.pollLoop(ctx, UpdateConfig{Timeout: timeout}, updates, nil)
+		close(updates)
+	}()
+
+	handle := func(u Update) { b.handleSafely(handler, u) }
+	var pool *workerPool
+	if cfg := newProcessConfig(opts); cfg.workers > 1 {
+		pool = newWorkerPool(cfg, handle)
+		handle = pool.submit
+	}
+
+	seen := newUpdateIDSet(dedupWindow)
+	for u := range updates {
+		if !seen.add(u.UpdateID) {
+			b.debugf("telegram: skipping duplicate update %d", u.UpdateID)
+			continue
+		}
+		handle(u)
+	}
+	if pool != nil {
+		pool.close()
+	}
+	if pollErr != nil {
+		return pollErr
+	}
+	return ctx.Err()
+}
+
+// handleSafely memanggil handler dan mencatat panic yang terjadi di dalamnya
+func (b *Bot) handleSafely(handler func(Update), u Update) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.errorf("telegram: panic handling update %d: %v\n%s", u.UpdateID, r, debug.Stack())
+		}
+	}()
+	handler(u)
+}
+
+// updateIDSet mengingat hingga size update_id terakhir
+type updateIDSet struct {
+	ids   map[int]struct{}
+	order []int
+	next  int
+}
+
+// newUpdateIDSet membuat updateIDSet berkapasitas size
+func newUpdateIDSet(size int) *updateIDSet {
+	return &updateIDSet{ids: make(map[int]struct{}, size), order: make([]int, 0, size)}
+}
+
+// add mencatat id; mengembalikan false jika id sudah pernah dicatat
+func (s *updateIDSet) add(id int) bool {
+	if _, ok := s.ids[id]; ok {
+		return false
+	}
+	if len(s.order) < cap(s.order) {
+		s.order = append(s.order, id)
+	} else {
+		delete(s.ids, s.order[s.next])
+		s.order[s.next] = id
+		s.next = (s.next + 1) % len(s.order)
+	}
+	s.ids[id] = struct{}{}
+	return true
+}
@@ -0,0 +1,28 @@
+package telegrambot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// ProfilePhotosOptions berisi parameter opsional getUserProfilePhotos. Field yang bernilai nol tidak dikirim.
+type ProfilePhotosOptions struct {
+	Offset int // offset, indeks foto pertama yang diambil
+	Limit  int // limit, 1-100 (default 100)
+}
+
+// GetUserProfilePhotos mengambil foto profil pengguna. Setiap foto berisi beberapa ukuran;
+// ukuran terbesar ada di akhir slice dan file_id-nya bisa diteruskan ke GetFile atau DownloadFileByID.
+func (b *Bot) GetUserProfilePhotos(userID int, opts ProfilePhotosOptions) (*UserProfilePhotos, error) {
+	data := url.Values{}
+	data.Set("user_id", strconv.Itoa(userID))
+	setOptionalInt(data, "offset", opts.Offset)
+	setOptionalInt(data, "limit", opts.Limit)
+
+	var photos UserProfilePhotos
+	if err := b.doRequest(context.Background(), "getUserProfilePhotos", data, &photos); err != nil {
+		return nil, err
+	}
+	return &photos, nil
+}
@@ -0,0 +1,151 @@
+package telegrambot
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit adalah jumlah request yang diizinkan per detik
+type RateLimit float64
+
+// Batas yang disarankan Telegram
+const (
+	DefaultGlobalRateLimit  RateLimit = 30
+	DefaultPerChatRateLimit RateLimit = 1
+)
+
+// maxIdleChatLimiters adalah jumlah limiter per chat sebelum limiter yang tidak aktif dibuang
+const maxIdleChatLimiters = 10000
+
+// SetRateLimits mengaktifkan token bucket untuk method pengiriman: global membatasi total
+// request per detik, perChat membatasi request per detik untuk setiap chat_id. Nilai <= 0
+// menonaktifkan batas tersebut. Panggil sebelum bot mulai mengirim pesan.
+func (b *Bot) SetRateLimits(global, perChat RateLimit) {
+	if global <= 0 && perChat <= 0 {
+		b.limiter = nil
+		return
+	}
+	b.limiter = &rateLimiter{
+		global:  newBucket(global),
+		perChat: perChat,
+		chats:   make(map[string]*bucket),
+	}
+}
+
+// rateLimiter menggabungkan bucket global dan bucket per chat
+type rateLimiter struct {
+	global  *bucket
+	perChat RateLimit
+
+	mu    sync.Mutex
+	chats map[string]*bucket
+}
+
+// wait menunggu sampai method boleh dikirim ke chatID, atau ctx dibatalkan
+func (l *rateLimiter) wait(ctx context.Context, method, chatID string) error {
+	if !isSendMethod(method) {
+		return nil
+	}
+	if chatID != "" {
+		if err := l.chat(chatID).wait(ctx); err != nil {
+			return err
+		}
+	}
+	return l.global.wait(ctx)
+}
+
+// chat mengembalikan bucket untuk chatID, membuatnya bila belum ada
+func (l *rateLimiter) chat(chatID string) *bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.chats[chatID]; ok {
+		return b
+	}
+	if len(l.chats) >= maxIdleChatLimiters {
+		now := time.Now()
+		for id, b := range l.chats {
+			if b.idle(now) {
+				delete(l.chats, id)
+			}
+		}
+	}
+	b := newBucket(l.perChat)
+	l.chats[chatID] = b
+	return b
+}
+
+// isSendMethod melaporkan apakah method mengirim pesan sehingga terkena rate limit
+func isSendMethod(method string) bool {
+	return strings.HasPrefix(method, "send") ||
+		strings.HasPrefix(method, "forwardMessage") ||
+		strings.HasPrefix(method, "copyMessage")
+}
+
+// bucket adalah token bucket sederhana; bucket nil tidak membatasi apa pun
+type bucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newBucket membuat bucket penuh dengan kapasitas sebesar rate (minimal 1), atau nil jika rate <= 0
+func newBucket(rate RateLimit) *bucket {
+	if rate <= 0 {
+		return nil
+	}
+	burst := math.Max(1, math.Floor(float64(rate)))
+	return &bucket{rate: float64(rate), burst: burst, tokens: burst, last: time.Now()}
+}
+
+// wait mengambil satu token, menunggu bila bucket kosong. Token dikembalikan jika ctx dibatalkan.
+func (b *bucket) wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	delay := b.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	if !sleepContext(ctx, delay) {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+	return nil
+}
+
+// reserve mengambil satu token dan mengembalikan lama tunggu sampai token tersebut tersedia
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// refill menambah token sesuai waktu yang berlalu sejak pengisian terakhir
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+		b.last = now
+	}
+}
+
+// idle melaporkan apakah bucket sudah penuh kembali sehingga aman dibuang
+func (b *bucket) idle(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	return b.tokens >= b.burst
+}
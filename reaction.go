@@ -0,0 +1,31 @@
+package telegrambot
+
+import "context"
+
+// NewReactionEmoji membuat ReactionType dari emoji standar, misalnya "👍"
+func NewReactionEmoji(emoji string) ReactionType {
+	return ReactionType{Type: "emoji", Emoji: emoji}
+}
+
+// NewReactionCustomEmoji membuat ReactionType dari custom emoji
+func NewReactionCustomEmoji(customEmojiID string) ReactionType {
+	return ReactionType{Type: "custom_emoji", CustomEmojiID: customEmojiID}
+}
+
+// setMessageReactionRequest adalah body JSON setMessageReaction
+type setMessageReactionRequest struct {
+	ChatID    int64          `json:"chat_id"`
+	MessageID int            `json:"message_id"`
+	Reaction  []ReactionType `json:"reaction"`
+	IsBig     bool           `json:"is_big,omitempty"`
+}
+
+// SetMessageReaction mengganti reaksi bot pada pesan; reactions kosong menghapus reaksi.
+// isBig menampilkan animasi reaksi yang lebih besar.
+func (b *Bot) SetMessageReaction(chatID int64, messageID int, reactions []ReactionType, isBig bool) error {
+	if reactions == nil {
+		reactions = []ReactionType{}
+	}
+	req := setMessageReactionRequest{ChatID: chatID, MessageID: messageID, Reaction: reactions, IsBig: isBig}
+	return b.doJSONRequest(context.Background(), "setMessageReaction", req, nil)
+}
@@ -0,0 +1,27 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Request memanggil method API apa pun dengan params sebagai body JSON dan mengembalikan
+// result mentah. Berguna untuk method baru yang belum dibungkus package ini. Upload file
+// tidak didukung; kirim file_id atau URL sebagai string.
+//
+//	raw, err := bot.Request(ctx, "getChatMenuButton", map[string]interface{}{"chat_id": chatID})
+//	if err != nil {
+//		return err
+//	}
+//	var button struct{ Type string `json:"type"` }
+//	err = json.Unmarshal(raw, &button)
+func (b *Bot) Request(ctx context.Context, method string, params map[string]interface{}) (json.RawMessage, error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	var result json.RawMessage
+	if err := b.doJSONRequest(ctx, method, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,230 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// captureServer merekam content type dan body JSON setiap request lalu membalas result
+func captureServer(t *testing.T, result string) (*Bot, *string, *map[string]interface{}) {
+	t.Helper()
+	var contentType string
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body = nil
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+	}))
+	t.Cleanup(srv.Close)
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	return bot, &contentType, &body
+}
+
+func TestNestedParamsSentAsJSON(t *testing.T) {
+	id := 1
+	tests := []struct {
+		name   string
+		result string
+		call   func(b *Bot) error
+		want   map[string]interface{}
+	}{
+		{
+			name:   "sendPoll",
+			result: `{"message_id":1,"chat":{"id":1,"type":"private"}}`,
+			call: func(b *Bot) error {
+				_, err := b.SendPoll(1, "Q?", []string{"a", "b"}, PollConfig{Type: PollTypeQuiz, CorrectOptionID: &id})
+				return err
+			},
+			want: map[string]interface{}{
+				"chat_id":           float64(1),
+				"question":          "Q?",
+				"options":           []interface{}{map[string]interface{}{"text": "a"}, map[string]interface{}{"text": "b"}},
+				"type":              "quiz",
+				"correct_option_id": float64(1),
+			},
+		},
+		{
+			name:   "setMyCommands",
+			result: `true`,
+			call: func(b *Bot) error {
+				return b.SetMyCommands([]BotCommand{{Command: "start", Description: "Mulai"}}, CommandScope{Type: ScopeChat, ChatID: 5, LanguageCode: "id"})
+			},
+			want: map[string]interface{}{
+				"commands":      []interface{}{map[string]interface{}{"command": "start", "description": "Mulai"}},
+				"scope":         map[string]interface{}{"type": "chat", "chat_id": float64(5)},
+				"language_code": "id",
+			},
+		},
+		{
+			name:   "editMessageLiveLocation",
+			result: `{"message_id":3,"chat":{"id":1,"type":"private"}}`,
+			call: func(b *Bot) error {
+				markup := NewInlineKeyboard().Row(NewInlineButtonData("Stop", "stop")).Build()
+				_, err := b.EditMessageLiveLocation(1, 3, -6.9, 107.6, EditLiveLocationOptions{Heading: 90, ReplyMarkup: markup})
+				return err
+			},
+			want: map[string]interface{}{
+				"chat_id":    float64(1),
+				"message_id": float64(3),
+				"latitude":   -6.9,
+				"longitude":  107.6,
+				"heading":    float64(90),
+				"reply_markup": map[string]interface{}{"inline_keyboard": []interface{}{
+					[]interface{}{map[string]interface{}{"text": "Stop", "callback_data": "stop"}},
+				}},
+			},
+		},
+		{
+			name:   "promoteChatMember sends false rights",
+			result: `true`,
+			call: func(b *Bot) error {
+				return b.PromoteChatMember(1, 2, ChatAdminRights{CanDeleteMessages: true})
+			},
+			want: nil, // diperiksa terpisah di bawah
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, contentType, body := captureServer(t, tt.result)
+			if err := tt.call(bot); err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			if *contentType != jsonContentType {
+				t.Errorf("Content-Type = %q, want %q", *contentType, jsonContentType)
+			}
+			if tt.want == nil {
+				if (*body)["can_delete_messages"] != true || (*body)["can_invite_users"] != false {
+					t.Errorf("body = %v, want every right present", *body)
+				}
+				return
+			}
+			if !reflect.DeepEqual(*body, tt.want) {
+				t.Errorf("body = %v, want %v", *body, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetUpdatesSendsJSON(t *testing.T) {
+	bot, contentType, body := captureServer(t, `[]`)
+	_, err := bot.GetUpdatesWithConfig(context.Background(), UpdateConfig{AllowedUpdates: []string{UpdateTypeMessage}})
+	if err != nil {
+		t.Fatalf("GetUpdatesWithConfig: %v", err)
+	}
+	if *contentType != jsonContentType {
+		t.Errorf("Content-Type = %q, want %q", *contentType, jsonContentType)
+	}
+	want := map[string]interface{}{"offset": float64(0), "allowed_updates": []interface{}{"message"}}
+	if !reflect.DeepEqual(*body, want) {
+		t.Errorf("body = %v, want %v", *body, want)
+	}
+}
+
+func TestSetChatPermissionsReadOnly(t *testing.T) {
+	bot, _, body := captureServer(t, `true`)
+	err := bot.SetChatPermissions(-100, ChatPermissions{}, ChatPermissionsOptions{UseIndependentChatPermissions: true})
+	if err != nil {
+		t.Fatalf("SetChatPermissions: %v", err)
+	}
+	perms, ok := (*body)["permissions"].(map[string]interface{})
+	if !ok || perms["can_send_messages"] != false {
+		t.Errorf("permissions = %v, want explicit can_send_messages false", (*body)["permissions"])
+	}
+	if (*body)["use_independent_chat_permissions"] != true {
+		t.Errorf("use_independent_chat_permissions = %v, want true", (*body)["use_independent_chat_permissions"])
+	}
+}
+
+func TestCallTimeoutSkipsPolling(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/getUpdates") {
+			w.Write([]byte(`{"ok":true,"result":[]}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	bot.CallTimeout = 50 * time.Millisecond
+
+	_, err := bot.SendMessage(1, "halo")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("SendMessage err = %v, want context.DeadlineExceeded", err)
+	}
+	// long polling tidak terkena CallTimeout
+	if _, err := bot.GetUpdatesTimeout(context.Background(), 0, 1); err != nil {
+		t.Fatalf("GetUpdatesTimeout: %v", err)
+	}
+}
+
+func TestSilentProtectedSends(t *testing.T) {
+	msg := `{"message_id":1,"chat":{"id":1,"type":"private"}}`
+	tests := []struct {
+		name string
+		call func(b *Bot) error
+	}{
+		{"sendContact", func(b *Bot) error {
+			_, err := b.SendContact(1, "+62", "Budi", ContactOptions{DisableNotification: true, ProtectContent: true})
+			return err
+		}},
+		{"sendLocation", func(b *Bot) error {
+			_, err := b.SendLocation(1, -6.9, 107.6, LocationOptions{DisableNotification: true, ProtectContent: true})
+			return err
+		}},
+		{"sendPoll", func(b *Bot) error {
+			_, err := b.SendPoll(1, "Q?", []string{"a", "b"}, PollConfig{DisableNotification: true, ProtectContent: true})
+			return err
+		}},
+		{"broadcast", func(b *Bot) error {
+			return b.Broadcast([]int64{1}, "info", SendMessageConfig{DisableNotification: true, ProtectContent: true})[0].Err
+		}},
+		{"copyMessage", func(b *Bot) error {
+			_, err := b.CopyMessageWithOptions(1, 2, 3, CopyOptions{DisableNotification: true, ProtectContent: true})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := msg
+			if tt.name == "copyMessage" {
+				result = `{"message_id":4}`
+			}
+			bot, _, body := captureServer(t, result)
+			if err := tt.call(bot); err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			if (*body)["disable_notification"] != true || (*body)["protect_content"] != true {
+				t.Errorf("body = %v, want disable_notification and protect_content", *body)
+			}
+		})
+	}
+
+	// nilai false tidak dikirim
+	bot, _, body := captureServer(t, msg)
+	if _, err := bot.SendContact(1, "+62", "Budi", ContactOptions{}); err != nil {
+		t.Fatalf("SendContact: %v", err)
+	}
+	if _, ok := (*body)["protect_content"]; ok {
+		t.Errorf("body = %v, want protect_content omitted", *body)
+	}
+}
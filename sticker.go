@@ -0,0 +1,33 @@
+package telegrambot
+
+import (
+	"context"
+	"net/url"
+)
+
+// SendSticker mengirim sticker (.WEBP, .TGS atau .WEBM). Sticker yang sudah ada di server
+// cukup dikirim dengan FileID(sticker.FileID).
+func (b *Bot) SendSticker(chatID int64, sticker InputFile) (*Message, error) {
+	return b.SendStickerWithOptions(chatID, sticker, SendOptions{})
+}
+
+// SendStickerWithOptions sama dengan SendSticker dengan opsi tambahan, misalnya MessageThreadID
+func (b *Bot) SendStickerWithOptions(chatID int64, sticker InputFile, opts SendOptions) (*Message, error) {
+	data := chatParams(chatID)
+	if err := opts.params(data); err != nil {
+		return nil, err
+	}
+	return b.sendMedia(context.Background(), "sendSticker", data, "sticker", sticker)
+}
+
+// GetStickerSet mengambil sticker set berdasarkan nama, misalnya Message.Sticker.SetName
+func (b *Bot) GetStickerSet(name string) (*StickerSet, error) {
+	data := url.Values{}
+	data.Set("name", name)
+
+	var set StickerSet
+	if err := b.doRequest(context.Background(), "getStickerSet", data, &set); err != nil {
+		return nil, err
+	}
+	return &set, nil
+}
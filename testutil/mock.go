@@ -0,0 +1,204 @@
+// Package testutil berisi MockServer untuk menguji handler bot tanpa request jaringan.
+//
+//	mock := testutil.NewMockServer()
+//	mock.On("sendMessage").Return(telegrambot.Message{MessageID: 1})
+//	bot := mock.Bot()
+//	// ... jalankan handler dengan bot ...
+//	req := mock.LastRequest("sendMessage")
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	telegrambot "github.com/VampXDH/telegram-bot-package"
+)
+
+// Token adalah token palsu yang dipakai Bot dari MockServer
+const Token = "123456:mock-token"
+
+// Request adalah satu request yang diterima MockServer
+type Request struct {
+	Method      string                 // nama method API, misalnya "sendMessage"
+	ContentType string                 // media type body tanpa parameter
+	Params      map[string]interface{} // body JSON ter-decode, atau nilai form/multipart sebagai string
+	Files       map[string][]byte      // isi file multipart per nama field
+}
+
+// response adalah jawaban yang sudah disiapkan untuk satu method
+type response struct {
+	status int
+	body   []byte
+}
+
+// MockServer merekam request Bot API dan membalas dengan response yang disiapkan per method.
+// MockServer adalah http.RoundTripper sehingga tidak membuka port; ia juga http.Handler
+// sehingga dapat dipasang di httptest.Server dan dipakai lewat Bot.BaseURL.
+// Method yang belum disiapkan dibalas 404.
+type MockServer struct {
+	mu        sync.Mutex
+	responses map[string]response
+	requests  []Request
+}
+
+// NewMockServer membuat MockServer kosong
+func NewMockServer() *MockServer {
+	return &MockServer{responses: make(map[string]response)}
+}
+
+// Bot membuat Bot dengan Token palsu yang semua request-nya dijawab oleh m
+func (m *MockServer) Bot() *telegrambot.Bot {
+	return telegrambot.NewBotWithClient(Token, &http.Client{Transport: m})
+}
+
+// Expectation menyiapkan response untuk satu method; dibuat dengan On
+type Expectation struct {
+	server *MockServer
+	method string
+}
+
+// On mulai menyiapkan response untuk method API
+func (m *MockServer) On(method string) *Expectation {
+	return &Expectation{server: m, method: method}
+}
+
+// Return membuat method membalas ok dengan result (di-serialize sebagai JSON).
+// Response untuk method yang sama ditimpa.
+func (e *Expectation) Return(result interface{}) {
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		panic(fmt.Sprintf("testutil: encode result for %s: %v", e.method, err))
+	}
+	e.set(http.StatusOK, []byte(`{"ok":true,"result":`+string(encoded)+`}`))
+}
+
+// ReturnError membuat method membalas error API, misalnya 403 "Forbidden: bot was blocked by the user"
+func (e *Expectation) ReturnError(code int, description string) {
+	body, _ := json.Marshal(map[string]interface{}{"ok": false, "error_code": code, "description": description})
+	e.set(code, body)
+}
+
+// set menyimpan response untuk method
+func (e *Expectation) set(status int, body []byte) {
+	e.server.mu.Lock()
+	defer e.server.mu.Unlock()
+	e.server.responses[e.method] = response{status: status, body: body}
+}
+
+// Requests mengembalikan salinan semua request yang diterima. Jika method diisi, hanya
+// request untuk method tersebut yang dikembalikan.
+func (m *MockServer) Requests(method string) []Request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Request
+	for _, r := range m.requests {
+		if method == "" || r.Method == method {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// LastRequest mengembalikan request terakhir untuk method, atau nil jika belum ada
+func (m *MockServer) LastRequest(method string) *Request {
+	reqs := m.Requests(method)
+	if len(reqs) == 0 {
+		return nil
+	}
+	return &reqs[len(reqs)-1]
+}
+
+// Reset menghapus request yang terekam; response yang disiapkan tetap ada
+func (m *MockServer) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = nil
+}
+
+// RoundTrip menjawab request langsung tanpa jaringan
+func (m *MockServer) RoundTrip(r *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, r)
+	resp := rec.Result()
+	resp.Request = r
+	return resp, nil
+}
+
+// ServeHTTP merekam request lalu menulis response yang disiapkan untuk method-nya
+func (m *MockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	m.requests = append(m.requests, req)
+	resp, ok := m.responses[req.Method]
+	m.mu.Unlock()
+	if !ok {
+		body, _ := json.Marshal(map[string]interface{}{
+			"ok": false, "error_code": http.StatusNotFound,
+			"description": fmt.Sprintf("Not Found: method %q is not mocked", req.Method),
+		})
+		resp = response{status: http.StatusNotFound, body: body}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	w.Write(resp.body)
+}
+
+// parseRequest mengambil nama method dari path /bot<token>/<method> lalu men-decode body
+func parseRequest(r *http.Request) (Request, error) {
+	req := Request{
+		Method: r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:],
+		Params: make(map[string]interface{}),
+	}
+	req.ContentType, _, _ = mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	switch req.ContentType {
+	case "application/json":
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return req, err
+		}
+		if err := json.Unmarshal(body, &req.Params); err != nil {
+			return req, fmt.Errorf("testutil: decode JSON body: %w", err)
+		}
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return req, err
+		}
+		for key := range r.MultipartForm.Value {
+			req.Params[key] = r.MultipartForm.Value[key][0]
+		}
+		req.Files = make(map[string][]byte)
+		for field, headers := range r.MultipartForm.File {
+			f, err := headers[0].Open()
+			if err != nil {
+				return req, err
+			}
+			data, err := ioutil.ReadAll(f)
+			f.Close()
+			if err != nil {
+				return req, err
+			}
+			req.Files[field] = data
+		}
+	default:
+		if err := r.ParseForm(); err != nil {
+			return req, err
+		}
+		for key := range r.PostForm {
+			req.Params[key] = r.PostForm.Get(key)
+		}
+	}
+	return req, nil
+}
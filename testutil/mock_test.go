@@ -0,0 +1,64 @@
+package testutil_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	telegrambot "github.com/VampXDH/telegram-bot-package"
+	"github.com/VampXDH/telegram-bot-package/testutil"
+)
+
+// echo adalah contoh handler yang diuji: membalas teks pesan ke chat asalnya
+func echo(bot *telegrambot.Bot, msg *telegrambot.Message) {
+	bot.SendMessage(msg.Chat.ID, strings.ToUpper(msg.Text))
+}
+
+func ExampleMockServer() {
+	mock := testutil.NewMockServer()
+	mock.On("sendMessage").Return(telegrambot.Message{MessageID: 1})
+
+	echo(mock.Bot(), &telegrambot.Message{Chat: telegrambot.Chat{ID: 42}, Text: "halo"})
+
+	req := mock.LastRequest("sendMessage")
+	fmt.Println(req.Params["chat_id"], req.Params["text"])
+	// Output: 42 HALO
+}
+
+func TestMockServer(t *testing.T) {
+	mock := testutil.NewMockServer()
+	mock.On("sendMessage").ReturnError(403, "Forbidden: bot was blocked by the user")
+	bot := mock.Bot()
+
+	_, err := bot.SendMessage(7, "halo")
+	if !telegrambot.IsBlocked(err) {
+		t.Fatalf("SendMessage err = %v, want blocked", err)
+	}
+
+	// form biasa direkam sebagai string
+	if err := bot.SendChatAction(7, telegrambot.ChatActionTyping); err == nil {
+		t.Error("unmocked method should fail")
+	}
+	req := mock.LastRequest("sendChatAction")
+	if req == nil || req.Params["action"] != "typing" || req.Params["chat_id"] != "7" {
+		t.Fatalf("sendChatAction request = %+v", req)
+	}
+
+	// upload multipart merekam isi file
+	mock.On("sendDocument").Return(telegrambot.Message{MessageID: 2})
+	if _, err := bot.SendDocument(7, telegrambot.FileReader("a.txt", strings.NewReader("isi")), "cap"); err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+	doc := mock.LastRequest("sendDocument")
+	if string(doc.Files["document"]) != "isi" || doc.Params["caption"] != "cap" {
+		t.Errorf("sendDocument request = %+v", doc)
+	}
+
+	if n := len(mock.Requests("")); n != 3 {
+		t.Errorf("len(Requests) = %d, want 3", n)
+	}
+	mock.Reset()
+	if n := len(mock.Requests("")); n != 0 {
+		t.Errorf("len(Requests) after Reset = %d, want 0", n)
+	}
+}
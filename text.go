@@ -0,0 +1,105 @@
+package telegrambot
+
+import "strings"
+
+// TextBuilder menyusun teks berformat beserta entity-nya sehingga tidak perlu escaping
+// MarkdownV2/HTML. Offset entity dihitung dalam unit UTF-16 seperti yang diharapkan Telegram.
+//
+//	text := telegrambot.NewText().Bold("hi").Plain(" ").Link("docs", "https://core.telegram.org/bots/api")
+//	bot.Send(telegrambot.SendMessageConfig{ChatID: chatID, Text: text.String(), Entities: text.Entities()})
+type TextBuilder struct {
+	sb       strings.Builder
+	length   int
+	entities []MessageEntity
+}
+
+// NewText membuat TextBuilder kosong
+func NewText() *TextBuilder {
+	return &TextBuilder{}
+}
+
+// Plain menambahkan teks tanpa format
+func (t *TextBuilder) Plain(s string) *TextBuilder {
+	t.sb.WriteString(s)
+	t.length += utf16Len(s)
+	return t
+}
+
+// Bold menambahkan teks tebal
+func (t *TextBuilder) Bold(s string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityBold})
+}
+
+// Italic menambahkan teks miring
+func (t *TextBuilder) Italic(s string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityItalic})
+}
+
+// Underline menambahkan teks bergaris bawah
+func (t *TextBuilder) Underline(s string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityUnderline})
+}
+
+// Strikethrough menambahkan teks dicoret
+func (t *TextBuilder) Strikethrough(s string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityStrike})
+}
+
+// Spoiler menambahkan teks tersembunyi
+func (t *TextBuilder) Spoiler(s string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntitySpoiler})
+}
+
+// Code menambahkan kode inline
+func (t *TextBuilder) Code(s string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityCode})
+}
+
+// Pre menambahkan blok kode dengan bahasa opsional
+func (t *TextBuilder) Pre(s, language string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityPre, Language: language})
+}
+
+// Link menambahkan teks yang menautkan ke url
+func (t *TextBuilder) Link(s, url string) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityTextLink, URL: url})
+}
+
+// Mention menambahkan mention ke user yang tidak memiliki username
+func (t *TextBuilder) Mention(s string, user User) *TextBuilder {
+	return t.add(s, MessageEntity{Type: EntityTextMention, User: &user})
+}
+
+// String mengembalikan teks polos yang dikirim sebagai text
+func (t *TextBuilder) String() string {
+	return t.sb.String()
+}
+
+// Entities mengembalikan entity untuk teks yang sudah disusun
+func (t *TextBuilder) Entities() []MessageEntity {
+	return t.entities
+}
+
+// add menambahkan s dan entity yang menandainya; teks kosong tidak menghasilkan entity
+func (t *TextBuilder) add(s string, e MessageEntity) *TextBuilder {
+	if s == "" {
+		return t
+	}
+	e.Offset = t.length
+	e.Length = utf16Len(s)
+	t.entities = append(t.entities, e)
+	return t.Plain(s)
+}
+
+// utf16Len menghitung panjang s dalam unit UTF-16
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
@@ -1,26 +1,291 @@
 package telegrambot
 
+import "encoding/json"
+
 // Update represents an update from Telegram
 type Update struct {
-	UpdateID int     `json:"update_id"`
-	Message  Message `json:"message"`
+	UpdateID          int            `json:"update_id"`
+	Message           Message        `json:"message"`
+	EditedMessage     *Message       `json:"edited_message"`
+	ChannelPost       *Message       `json:"channel_post"`
+	EditedChannelPost *Message       `json:"edited_channel_post"`
+	InlineQuery       *InlineQuery   `json:"inline_query"`
+	CallbackQuery     *CallbackQuery `json:"callback_query"`
+	Poll              *Poll          `json:"poll"`
+	PollAnswer        *PollAnswer    `json:"poll_answer"`
+
+	// MyChatMember is sent when the bot's own membership changes. ChatMember is only
+	// sent when "chat_member" is listed explicitly in allowed_updates.
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member"`
+	ChatMember   *ChatMemberUpdated `json:"chat_member"`
+
+	// MessageReaction is only sent when "message_reaction" is listed in allowed_updates
+	// and the bot is an administrator of the chat.
+	MessageReaction *MessageReactionUpdated `json:"message_reaction"`
+
+	// ShippingQuery is only sent for invoices with flexible prices. PreCheckoutQuery
+	// must be answered within 10 seconds or the payment is cancelled.
+	ShippingQuery    *ShippingQuery    `json:"shipping_query"`
+	PreCheckoutQuery *PreCheckoutQuery `json:"pre_checkout_query"`
+
+	// ChatJoinRequest is sent when a user asks to join through an invite link with
+	// creates_join_request; the bot needs the can_invite_users right.
+	ChatJoinRequest *ChatJoinRequest `json:"chat_join_request"`
 }
 
 // Message represents a message from Telegram
 type Message struct {
-	MessageID int      `json:"message_id"`
-	From      User     `json:"from"`
-	Chat      Chat     `json:"chat"`
-	Date      int      `json:"date"`
-	Text      string   `json:"text"`
-	Document  Document `json:"document"`  // Field untuk dokumen yang dikirim
+	MessageID       int         `json:"message_id"`
+	From            User        `json:"from"`
+	Chat            Chat        `json:"chat"`
+	Date            int         `json:"date"`
+	Text            string      `json:"text"`
+	Entities        []Entity    `json:"entities"`
+	Caption         string      `json:"caption"`          // caption of photo, video, document, ...
+	CaptionEntities []Entity    `json:"caption_entities"` // offsets refer to Caption
+	Document        Document    `json:"document"`         // Field untuk dokumen yang dikirim
+	Poll            *Poll       `json:"poll"`
+	ReplyToMessage  *Message    `json:"reply_to_message"` // nested replies are not included by Telegram
+	Video           *Video      `json:"video"`
+	Audio           *Audio      `json:"audio"`
+	Voice           *Voice      `json:"voice"`
+	VideoNote       *VideoNote  `json:"video_note"`
+	Animation       *Animation  `json:"animation"`
+	Dice            *Dice       `json:"dice"`
+	Contact         *Contact    `json:"contact"`
+	Location        *Location   `json:"location"`
+	Venue           *Venue      `json:"venue"` // Location is also set for venues
+	Sticker         *Sticker    `json:"sticker"`
+	WebAppData      *WebAppData `json:"web_app_data"`
+	MessageThreadID int         `json:"message_thread_id"`
+	Photo           []PhotoSize `json:"photo"`
+
+	// Service messages sent in the normal message stream
+	NewChatMembers   []User      `json:"new_chat_members"`
+	LeftChatMember   *User       `json:"left_chat_member"`
+	NewChatTitle     string      `json:"new_chat_title"`
+	NewChatPhoto     []PhotoSize `json:"new_chat_photo"`
+	DeleteChatPhoto  bool        `json:"delete_chat_photo"`
+	GroupChatCreated bool        `json:"group_chat_created"`
+
+	Game              *Game              `json:"game"`
+	Invoice           *Invoice           `json:"invoice"`
+	SuccessfulPayment *SuccessfulPayment `json:"successful_payment"`
+}
+
+// MessageEntity is the name Telegram uses for Entity
+type MessageEntity = Entity
+
+// MessageStruct is kept as an alias of Message for backward compatibility
+type MessageStruct = Message
+
+// Entity represents a special entity in a text message (command, mention, URL, ...)
+type Entity struct {
+	Offset        int    `json:"offset"` // in UTF-16 code units
+	Length        int    `json:"length"` // in UTF-16 code units
+	Type          string `json:"type"`
+	URL           string `json:"url,omitempty"`             // for "text_link"
+	User          *User  `json:"user,omitempty"`            // for "text_mention"
+	Language      string `json:"language,omitempty"`        // for "pre"
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"` // for "custom_emoji"
+}
+
+// InlineQuery represents an incoming inline query (@bot query)
+type InlineQuery struct {
+	ID       string `json:"id"`
+	From     User   `json:"from"`
+	Query    string `json:"query"`
+	Offset   string `json:"offset"`
+	ChatType string `json:"chat_type"`
+}
+
+// CallbackQuery represents a press on an inline keyboard button
+type CallbackQuery struct {
+	ID              string   `json:"id"`
+	From            User     `json:"from"`
+	Message         *Message `json:"message"`
+	InlineMessageID string   `json:"inline_message_id"`
+	ChatInstance    string   `json:"chat_instance"`
+	Data            string   `json:"data"`
+	GameShortName   string   `json:"game_short_name"`
+}
+
+// PhotoSize represents one size of a photo or a file/sticker thumbnail
+type PhotoSize struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	FileSize     int64  `json:"file_size"`
+}
+
+// UserProfilePhotos represents a user's profile pictures, each in several sizes
+type UserProfilePhotos struct {
+	TotalCount int           `json:"total_count"`
+	Photos     [][]PhotoSize `json:"photos"`
+}
+
+// Sticker represents a sticker
+type Sticker struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Type         string `json:"type"` // "regular", "mask" or "custom_emoji"
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	IsAnimated   bool   `json:"is_animated"`
+	IsVideo      bool   `json:"is_video"`
+	Emoji        string `json:"emoji"`
+	SetName      string `json:"set_name"`
+	FileSize     int64  `json:"file_size"`
+}
+
+// StickerSet represents a sticker set
+type StickerSet struct {
+	Name        string    `json:"name"`
+	Title       string    `json:"title"`
+	StickerType string    `json:"sticker_type"`
+	Stickers    []Sticker `json:"stickers"`
+}
+
+// ForumTopic represents a forum topic in a supergroup
+type ForumTopic struct {
+	MessageThreadID   int    `json:"message_thread_id"`
+	Name              string `json:"name"`
+	IconColor         int    `json:"icon_color"`
+	IconCustomEmojiID string `json:"icon_custom_emoji_id"`
+}
+
+// Contact represents a phone contact
+type Contact struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	UserID      int    `json:"user_id"` // set when the contact is a Telegram user
+	VCard       string `json:"vcard"`
+}
+
+// Location represents a point on the map
+type Location struct {
+	Latitude             float64 `json:"latitude"`
+	Longitude            float64 `json:"longitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy"`    // in meters, 0-1500
+	LivePeriod           int     `json:"live_period"`            // seconds, set for live locations
+	Heading              int     `json:"heading"`                // degrees, 1-360, live locations only
+	ProximityAlertRadius int     `json:"proximity_alert_radius"` // meters, live locations only
+}
+
+// Venue represents a venue (a named place with an address)
+type Venue struct {
+	Location        Location `json:"location"`
+	Title           string   `json:"title"`
+	Address         string   `json:"address"`
+	FoursquareID    string   `json:"foursquare_id"`
+	FoursquareType  string   `json:"foursquare_type"`
+	GooglePlaceID   string   `json:"google_place_id"`
+	GooglePlaceType string   `json:"google_place_type"`
+}
+
+// Dice represents an animated emoji with a random value
+type Dice struct {
+	Emoji string `json:"emoji"`
+	Value int    `json:"value"` // 1-6 for 🎲 🎯 🎳, 1-5 for 🏀 ⚽, 1-64 for 🎰
+}
+
+// Poll represents a native Telegram poll
+type Poll struct {
+	ID                    string       `json:"id"`
+	Question              string       `json:"question"`
+	Options               []PollOption `json:"options"`
+	TotalVoterCount       int          `json:"total_voter_count"`
+	IsClosed              bool         `json:"is_closed"`
+	IsAnonymous           bool         `json:"is_anonymous"`
+	Type                  string       `json:"type"`
+	AllowsMultipleAnswers bool         `json:"allows_multiple_answers"`
+	CorrectOptionID       *int         `json:"correct_option_id"` // quiz only, visible to the bot after it sent the poll
+	Explanation           string       `json:"explanation"`
+	OpenPeriod            int          `json:"open_period"`
+	CloseDate             int          `json:"close_date"`
+}
+
+// PollOption represents one answer option of a poll
+type PollOption struct {
+	Text       string `json:"text"`
+	VoterCount int    `json:"voter_count"`
+}
+
+// PollAnswer represents a user's answer in a non-anonymous poll
+type PollAnswer struct {
+	PollID    string `json:"poll_id"`
+	User      *User  `json:"user"`
+	OptionIDs []int  `json:"option_ids"` // empty when the user retracted their vote
 }
 
 // Document represents a document sent to the bot
 type Document struct {
-	FileID   string `json:"file_id"`
-	FileName string `json:"file_name"`
-	FileSize int    `json:"file_size"`
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	FileName     string     `json:"file_name"`
+	MimeType     string     `json:"mime_type"`
+	FileSize     int        `json:"file_size"`
+	Thumbnail    *PhotoSize `json:"thumbnail"`
+}
+
+// Video represents a video file
+type Video struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	Duration     int        `json:"duration"` // in seconds
+	Thumbnail    *PhotoSize `json:"thumbnail"`
+	FileName     string     `json:"file_name"`
+	MimeType     string     `json:"mime_type"`
+	FileSize     int64      `json:"file_size"`
+}
+
+// VideoNote represents a round video message
+type VideoNote struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Length       int        `json:"length"`   // width and height (diameter) of the video
+	Duration     int        `json:"duration"` // in seconds
+	Thumbnail    *PhotoSize `json:"thumbnail"`
+	FileSize     int64      `json:"file_size"`
+}
+
+// Audio represents an audio file treated as music
+type Audio struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Duration     int        `json:"duration"` // in seconds
+	Performer    string     `json:"performer"`
+	Title        string     `json:"title"`
+	FileName     string     `json:"file_name"`
+	MimeType     string     `json:"mime_type"`
+	FileSize     int64      `json:"file_size"`
+	Thumbnail    *PhotoSize `json:"thumbnail"` // album cover
+}
+
+// Voice represents a voice note
+type Voice struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Duration     int    `json:"duration"` // in seconds
+	MimeType     string `json:"mime_type"`
+	FileSize     int64  `json:"file_size"`
+}
+
+// Animation represents a GIF or H.264/MPEG-4 AVC video without sound
+type Animation struct {
+	FileID       string     `json:"file_id"`
+	FileUniqueID string     `json:"file_unique_id"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	Duration     int        `json:"duration"` // in seconds
+	Thumbnail    *PhotoSize `json:"thumbnail"`
+	FileName     string     `json:"file_name"`
+	MimeType     string     `json:"mime_type"`
+	FileSize     int64      `json:"file_size"`
 }
 
 // User represents a user on Telegram
@@ -31,6 +296,11 @@ type User struct {
 	LastName     string `json:"last_name"`
 	Username     string `json:"username"`
 	LanguageCode string `json:"language_code"`
+
+	// Only returned by getMe
+	CanJoinGroups           bool `json:"can_join_groups"`
+	CanReadAllGroupMessages bool `json:"can_read_all_group_messages"`
+	SupportsInlineQueries   bool `json:"supports_inline_queries"`
 }
 
 // Chat represents a chat on Telegram
@@ -41,6 +311,72 @@ type Chat struct {
 	Username  string `json:"username"`
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
+	IsForum   bool   `json:"is_forum"`
+
+	// Only returned by getChat
+	Description   string           `json:"description"`
+	InviteLink    string           `json:"invite_link"`
+	PinnedMessage *Message         `json:"pinned_message"`
+	Permissions   *ChatPermissions `json:"permissions"`
+}
+
+// ChatPermissions describes the actions a non-administrator member is allowed to take
+type ChatPermissions struct {
+	CanSendMessages       bool `json:"can_send_messages"`
+	CanSendAudios         bool `json:"can_send_audios"`
+	CanSendDocuments      bool `json:"can_send_documents"`
+	CanSendPhotos         bool `json:"can_send_photos"`
+	CanSendVideos         bool `json:"can_send_videos"`
+	CanSendVideoNotes     bool `json:"can_send_video_notes"`
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes"`
+	CanSendPolls          bool `json:"can_send_polls"`
+	CanSendOtherMessages  bool `json:"can_send_other_messages"`
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews"`
+	CanChangeInfo         bool `json:"can_change_info"`
+	CanInviteUsers        bool `json:"can_invite_users"`
+	CanPinMessages        bool `json:"can_pin_messages"`
+	CanManageTopics       bool `json:"can_manage_topics"`
+}
+
+// ChatMember represents a member of a chat. Which fields are set depends on Status.
+type ChatMember struct {
+	Status      string `json:"status"` // "creator", "administrator", "member", "restricted", "left" or "kicked"
+	User        User   `json:"user"`
+	CustomTitle string `json:"custom_title"`
+	IsMember    bool   `json:"is_member"`  // restricted only
+	UntilDate   int    `json:"until_date"` // restricted and kicked only, 0 means forever
+	CanBeEdited bool   `json:"can_be_edited"`
+
+	// Administrator rights, set for "creator" and "administrator"
+	ChatAdminRights
+}
+
+// ChatMemberUpdated represents a change in the membership of a chat member
+type ChatMemberUpdated struct {
+	Chat          Chat       `json:"chat"`
+	From          User       `json:"from"`
+	Date          int        `json:"date"`
+	OldChatMember ChatMember `json:"old_chat_member"`
+	NewChatMember ChatMember `json:"new_chat_member"`
+}
+
+// ChatAdminRights describes the rights of an administrator in a chat
+type ChatAdminRights struct {
+	IsAnonymous         bool `json:"is_anonymous"`
+	CanManageChat       bool `json:"can_manage_chat"`
+	CanDeleteMessages   bool `json:"can_delete_messages"`
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	CanRestrictMembers  bool `json:"can_restrict_members"`
+	CanPromoteMembers   bool `json:"can_promote_members"`
+	CanChangeInfo       bool `json:"can_change_info"`
+	CanInviteUsers      bool `json:"can_invite_users"`
+	CanPostStories      bool `json:"can_post_stories"`
+	CanEditStories      bool `json:"can_edit_stories"`
+	CanDeleteStories    bool `json:"can_delete_stories"`
+	CanPostMessages     bool `json:"can_post_messages"` // channels only
+	CanEditMessages     bool `json:"can_edit_messages"` // channels only
+	CanPinMessages      bool `json:"can_pin_messages"`
+	CanManageTopics     bool `json:"can_manage_topics"` // supergroups only
 }
 
 // UpdateResponse represents the response from Telegram getUpdates method
@@ -49,14 +385,328 @@ type UpdateResponse struct {
 	Result []Update `json:"result"`
 }
 
+// ResponseParameters describes why a request was unsuccessful
+type ResponseParameters struct {
+	MigrateToChatID int64 `json:"migrate_to_chat_id"`
+	RetryAfter      int   `json:"retry_after"`
+}
+
 // FileResponse represents the response from Telegram getFile method
 type FileResponse struct {
-	Ok     bool   `json:"ok"`
-	Result File   `json:"result"`
+	Ok     bool `json:"ok"`
+	Result File `json:"result"`
 }
 
 // File represents the file information from Telegram getFile response
 type File struct {
-	FileID   string `json:"file_id"`
-	FilePath string `json:"file_path"`
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileSize     int64  `json:"file_size"`
+	FilePath     string `json:"file_path"`
+}
+
+// WebhookInfo represents the current status of a webhook (getWebhookInfo)
+type WebhookInfo struct {
+	URL                          string   `json:"url"`
+	HasCustomCertificate         bool     `json:"has_custom_certificate"`
+	PendingUpdateCount           int      `json:"pending_update_count"`
+	IPAddress                    string   `json:"ip_address"`
+	LastErrorDate                int      `json:"last_error_date"`
+	LastErrorMessage             string   `json:"last_error_message"`
+	LastSynchronizationErrorDate int      `json:"last_synchronization_error_date"`
+	MaxConnections               int      `json:"max_connections"`
+	AllowedUpdates               []string `json:"allowed_updates"`
+}
+
+// MessageID represents a unique message identifier returned by copyMessage
+type MessageID struct {
+	MessageID int `json:"message_id"`
+}
+
+// ReplyMarkup is implemented by every type accepted in the reply_markup field
+type ReplyMarkup interface {
+	replyMarkup()
+}
+
+// ReplyParameters describes the message being replied to, optionally in another chat
+// and with a quoted part of its text
+type ReplyParameters struct {
+	MessageID                int    `json:"message_id"`
+	ChatID                   int64  `json:"chat_id,omitempty"` // reply to a message in another chat
+	AllowSendingWithoutReply bool   `json:"allow_sending_without_reply,omitempty"`
+	Quote                    string `json:"quote,omitempty"` // exact substring of the original message
+	QuoteParseMode           string `json:"quote_parse_mode,omitempty"`
+	QuotePosition            int    `json:"quote_position,omitempty"` // UTF-16 offset of the quote
+}
+
+// LinkPreviewOptions describes how the link preview of a message is generated and shown
+type LinkPreviewOptions struct {
+	IsDisabled       bool   `json:"is_disabled,omitempty"`
+	URL              string `json:"url,omitempty"` // preview this URL instead of the first one in the text
+	PreferSmallMedia bool   `json:"prefer_small_media,omitempty"`
+	PreferLargeMedia bool   `json:"prefer_large_media,omitempty"`
+	ShowAboveText    bool   `json:"show_above_text,omitempty"`
+}
+
+// InlineKeyboardMarkup represents an inline keyboard attached to a message
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// WebAppInfo describes a Web App (Mini App) launched from a button
+type WebAppInfo struct {
+	URL string `json:"url"`
+}
+
+// WebAppData contains data sent from a Web App to the bot
+type WebAppData struct {
+	Data       string `json:"data"`
+	ButtonText string `json:"button_text"`
+}
+
+// SentWebAppMessage describes an inline message sent by a Web App on behalf of a user
+type SentWebAppMessage struct {
+	InlineMessageID string `json:"inline_message_id"`
+}
+
+// InlineKeyboardButton represents one button of an inline keyboard
+type InlineKeyboardButton struct {
+	Text                         string        `json:"text"`
+	URL                          string        `json:"url,omitempty"`
+	CallbackData                 string        `json:"callback_data,omitempty"`
+	SwitchInlineQuery            string        `json:"switch_inline_query,omitempty"`
+	SwitchInlineQueryCurrentChat string        `json:"switch_inline_query_current_chat,omitempty"`
+	WebApp                       *WebAppInfo   `json:"web_app,omitempty"`
+	CallbackGame                 *CallbackGame `json:"callback_game,omitempty"` // must be the first button of the first row
+}
+
+// CallbackGame is a placeholder that makes an inline button launch the message's game
+type CallbackGame struct{}
+
+func (InlineKeyboardMarkup) replyMarkup() {}
+
+// ReplyKeyboardMarkup represents a custom keyboard shown instead of the system keyboard
+type ReplyKeyboardMarkup struct {
+	Keyboard              [][]KeyboardButton `json:"keyboard"`
+	IsPersistent          bool               `json:"is_persistent,omitempty"`
+	ResizeKeyboard        bool               `json:"resize_keyboard,omitempty"`
+	OneTimeKeyboard       bool               `json:"one_time_keyboard,omitempty"`
+	InputFieldPlaceholder string             `json:"input_field_placeholder,omitempty"`
+	Selective             bool               `json:"selective,omitempty"`
+}
+
+// KeyboardButton represents one button of a reply keyboard
+type KeyboardButton struct {
+	Text            string      `json:"text"`
+	RequestContact  bool        `json:"request_contact,omitempty"`
+	RequestLocation bool        `json:"request_location,omitempty"`
+	WebApp          *WebAppInfo `json:"web_app,omitempty"`
+}
+
+// ReplyKeyboardRemove asks clients to remove the current custom keyboard
+type ReplyKeyboardRemove struct {
+	Selective bool `json:"selective,omitempty"`
+}
+
+// MarshalJSON always sets remove_keyboard to true as required by Telegram
+func (r ReplyKeyboardRemove) MarshalJSON() ([]byte, error) {
+	type plain ReplyKeyboardRemove
+	return json.Marshal(struct {
+		RemoveKeyboard bool `json:"remove_keyboard"`
+		plain
+	}{true, plain(r)})
+}
+
+// ForceReply asks clients to show a reply interface for the sent message
+type ForceReply struct {
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+	Selective             bool   `json:"selective,omitempty"`
+}
+
+// MarshalJSON always sets force_reply to true as required by Telegram
+func (f ForceReply) MarshalJSON() ([]byte, error) {
+	type plain ForceReply
+	return json.Marshal(struct {
+		ForceReply bool `json:"force_reply"`
+		plain
+	}{true, plain(f)})
+}
+
+func (ReplyKeyboardMarkup) replyMarkup() {}
+func (ReplyKeyboardRemove) replyMarkup() {}
+func (ForceReply) replyMarkup()          {}
+
+// BotCommand represents a command shown in the client's command menu
+type BotCommand struct {
+	Command     string `json:"command"`
+	Description string `json:"description"`
+}
+
+// ChatInviteLink represents an invite link for a chat
+type ChatInviteLink struct {
+	InviteLink              string `json:"invite_link"`
+	Creator                 User   `json:"creator"`
+	CreatesJoinRequest      bool   `json:"creates_join_request"`
+	IsPrimary               bool   `json:"is_primary"`
+	IsRevoked               bool   `json:"is_revoked"`
+	Name                    string `json:"name"`
+	ExpireDate              int    `json:"expire_date"`
+	MemberLimit             int    `json:"member_limit"`
+	PendingJoinRequestCount int    `json:"pending_join_request_count"`
+}
+
+// ChatJoinRequest represents a request to join a chat
+type ChatJoinRequest struct {
+	Chat       Chat            `json:"chat"`
+	From       User            `json:"from"`
+	UserChatID int64           `json:"user_chat_id"` // private chat with the user, usable for 5 minutes
+	Date       int             `json:"date"`
+	Bio        string          `json:"bio"`
+	InviteLink *ChatInviteLink `json:"invite_link"` // link used to send the request
+}
+
+// ReactionType describes a reaction: an emoji or a custom emoji
+type ReactionType struct {
+	Type          string `json:"type"` // "emoji" or "custom_emoji"
+	Emoji         string `json:"emoji,omitempty"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// MessageReactionUpdated represents a change of a reaction on a message by a user
+type MessageReactionUpdated struct {
+	Chat        Chat           `json:"chat"`
+	MessageID   int            `json:"message_id"`
+	User        *User          `json:"user"`
+	ActorChat   *Chat          `json:"actor_chat"` // set for anonymous reactions
+	Date        int            `json:"date"`
+	OldReaction []ReactionType `json:"old_reaction"`
+	NewReaction []ReactionType `json:"new_reaction"`
+}
+
+// LabeledPrice represents a portion of the price for goods or services
+type LabeledPrice struct {
+	Label  string `json:"label"`
+	Amount int    `json:"amount"` // in the smallest units of the currency (cents for USD)
+}
+
+// Invoice contains basic information about an invoice
+type Invoice struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	StartParameter string `json:"start_parameter"`
+	Currency       string `json:"currency"`
+	TotalAmount    int    `json:"total_amount"`
+}
+
+// ShippingAddress represents a shipping address
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"` // ISO 3166-1 alpha-2
+	State       string `json:"state"`
+	City        string `json:"city"`
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2"`
+	PostCode    string `json:"post_code"`
+}
+
+// OrderInfo represents information about an order entered by the user
+type OrderInfo struct {
+	Name            string           `json:"name"`
+	PhoneNumber     string           `json:"phone_number"`
+	Email           string           `json:"email"`
+	ShippingAddress *ShippingAddress `json:"shipping_address"`
+}
+
+// ShippingOption represents one shipping option offered in answerShippingQuery
+type ShippingOption struct {
+	ID     string         `json:"id"`
+	Title  string         `json:"title"`
+	Prices []LabeledPrice `json:"prices"`
+}
+
+// ShippingQuery contains information about an incoming shipping query
+type ShippingQuery struct {
+	ID              string          `json:"id"`
+	From            User            `json:"from"`
+	InvoicePayload  string          `json:"invoice_payload"`
+	ShippingAddress ShippingAddress `json:"shipping_address"`
+}
+
+// PreCheckoutQuery contains information about an incoming pre-checkout query
+type PreCheckoutQuery struct {
+	ID               string     `json:"id"`
+	From             User       `json:"from"`
+	Currency         string     `json:"currency"`
+	TotalAmount      int        `json:"total_amount"`
+	InvoicePayload   string     `json:"invoice_payload"`
+	ShippingOptionID string     `json:"shipping_option_id"`
+	OrderInfo        *OrderInfo `json:"order_info"`
+}
+
+// SuccessfulPayment contains basic information about a successful payment
+type SuccessfulPayment struct {
+	Currency                string     `json:"currency"`
+	TotalAmount             int        `json:"total_amount"`
+	InvoicePayload          string     `json:"invoice_payload"`
+	ShippingOptionID        string     `json:"shipping_option_id"`
+	OrderInfo               *OrderInfo `json:"order_info"`
+	TelegramPaymentChargeID string     `json:"telegram_payment_charge_id"`
+	ProviderPaymentChargeID string     `json:"provider_payment_charge_id"`
+}
+
+// MenuButton is the bot's menu button in a private chat: MenuButtonCommands,
+// MenuButtonWebApp or MenuButtonDefault
+type MenuButton interface {
+	menuButton()
+}
+
+// MenuButtonCommands opens the bot's list of commands
+type MenuButtonCommands struct{}
+
+// MenuButtonWebApp launches a Web App
+type MenuButtonWebApp struct {
+	Text   string     `json:"text"`
+	WebApp WebAppInfo `json:"web_app"`
+}
+
+// MenuButtonDefault means no specific value for the menu button was set
+type MenuButtonDefault struct{}
+
+func (MenuButtonCommands) menuButton() {}
+func (MenuButtonWebApp) menuButton()   {}
+func (MenuButtonDefault) menuButton()  {}
+
+// MarshalJSON adds the "commands" type discriminator
+func (MenuButtonCommands) MarshalJSON() ([]byte, error) {
+	return []byte(`{"type":"commands"}`), nil
+}
+
+// MarshalJSON adds the "web_app" type discriminator
+func (b MenuButtonWebApp) MarshalJSON() ([]byte, error) {
+	type plain MenuButtonWebApp
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		plain
+	}{"web_app", plain(b)})
+}
+
+// MarshalJSON adds the "default" type discriminator
+func (MenuButtonDefault) MarshalJSON() ([]byte, error) {
+	return []byte(`{"type":"default"}`), nil
+}
+
+// Game represents an HTML5 game sent with sendGame
+type Game struct {
+	Title        string      `json:"title"`
+	Description  string      `json:"description"`
+	Photo        []PhotoSize `json:"photo"`
+	Text         string      `json:"text"` // set by the bot with setGameScore, or edited later
+	TextEntities []Entity    `json:"text_entities"`
+	Animation    *Animation  `json:"animation"`
+}
+
+// GameHighScore represents one row of a game's high scores table
+type GameHighScore struct {
+	Position int  `json:"position"`
+	User     User `json:"user"`
+	Score    int  `json:"score"`
 }
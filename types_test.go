@@ -0,0 +1,163 @@
+package telegrambot
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMessageReplyToMessageRoundTrip(t *testing.T) {
+	payload := `{
+		"message_id": 11,
+		"chat": {"id": -100, "type": "supergroup"},
+		"text": "/ban",
+		"reply_to_message": {
+			"message_id": 10,
+			"from": {"id": 42, "first_name": "Spammer"},
+			"chat": {"id": -100, "type": "supergroup"},
+			"text": "buy now"
+		}
+	}`
+
+	var msg Message
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	reply := msg.ReplyToMessage
+	if reply == nil {
+		t.Fatal("ReplyToMessage is nil")
+	}
+	if reply.MessageID != 10 || reply.Text != "buy now" || reply.From.ID != 42 {
+		t.Fatalf("ReplyToMessage = %+v", reply)
+	}
+	if reply.ReplyToMessage != nil {
+		t.Fatalf("nested ReplyToMessage = %+v, want nil", reply.ReplyToMessage)
+	}
+
+	encoded, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var again Message
+	if err := json.Unmarshal(encoded, &again); err != nil {
+		t.Fatalf("Unmarshal round trip: %v", err)
+	}
+	if again.ReplyToMessage == nil || again.ReplyToMessage.MessageID != 10 || again.ReplyToMessage.From.ID != 42 {
+		t.Fatalf("round trip ReplyToMessage = %+v", again.ReplyToMessage)
+	}
+	if again.MessageID != 11 || again.Text != "/ban" {
+		t.Fatalf("round trip message = %+v", again)
+	}
+}
+
+func TestMessageNewChatMembers(t *testing.T) {
+	payload := `{
+		"message_id": 3,
+		"from": {"id": 1, "first_name": "Admin"},
+		"chat": {"id": -100, "type": "supergroup", "title": "Grup"},
+		"date": 1700000000,
+		"new_chat_members": [
+			{"id": 2, "first_name": "Budi"},
+			{"id": 3, "first_name": "Sari", "username": "sari"}
+		]
+	}`
+	var msg Message
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(msg.NewChatMembers) != 2 {
+		t.Fatalf("len(NewChatMembers) = %d, want 2", len(msg.NewChatMembers))
+	}
+	if msg.NewChatMembers[1].Username != "sari" {
+		t.Errorf("NewChatMembers[1] = %+v", msg.NewChatMembers[1])
+	}
+	if msg.LeftChatMember != nil || msg.GroupChatCreated {
+		t.Errorf("unexpected service fields: left=%v created=%v", msg.LeftChatMember, msg.GroupChatCreated)
+	}
+
+	var left Message
+	if err := json.Unmarshal([]byte(`{"message_id": 4, "left_chat_member": {"id": 2, "first_name": "Budi"}}`), &left); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if left.LeftChatMember == nil || left.LeftChatMember.ID != 2 {
+		t.Errorf("LeftChatMember = %+v", left.LeftChatMember)
+	}
+}
+
+func TestMessageLocationAndVenue(t *testing.T) {
+	var live Message
+	err := json.Unmarshal([]byte(`{"message_id": 1, "location": {"latitude": -6.914744, "longitude": 107.60981, "live_period": 900, "heading": 90}}`), &live)
+	if err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	loc := live.Location
+	if loc == nil || loc.Latitude != -6.914744 || loc.Longitude != 107.60981 || loc.LivePeriod != 900 || loc.Heading != 90 {
+		t.Fatalf("Location = %+v", loc)
+	}
+	if live.Venue != nil {
+		t.Errorf("Venue = %+v, want nil", live.Venue)
+	}
+
+	var venue Message
+	err = json.Unmarshal([]byte(`{
+		"message_id": 2,
+		"location": {"latitude": -6.9, "longitude": 107.6},
+		"venue": {"location": {"latitude": -6.9, "longitude": 107.6}, "title": "Gedung Sate", "address": "Jl. Diponegoro 22"}
+	}`), &venue)
+	if err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if venue.Venue == nil || venue.Venue.Title != "Gedung Sate" || venue.Venue.Location.Latitude != -6.9 {
+		t.Errorf("Venue = %+v", venue.Venue)
+	}
+}
+
+func TestMessageMediaMetadata(t *testing.T) {
+	thumb := `"thumbnail": {"file_id": "thumb", "file_unique_id": "t", "width": 320, "height": 180}`
+	tests := []struct {
+		name    string
+		payload string
+		check   func(m Message) bool
+	}{
+		{"audio", `{"audio": {"file_id": "a", "duration": 215, "performer": "Band", "title": "Lagu", "mime_type": "audio/mpeg", "file_size": 5000000, ` + thumb + `}}`,
+			func(m Message) bool {
+				a := m.Audio
+				return a != nil && a.Duration == 215 && a.Performer == "Band" && a.MimeType == "audio/mpeg" && a.Thumbnail != nil && a.Thumbnail.FileID == "thumb"
+			}},
+		{"video", `{"video": {"file_id": "v", "width": 1920, "height": 1080, "duration": 12, "mime_type": "video/mp4", ` + thumb + `}}`,
+			func(m Message) bool {
+				v := m.Video
+				return v != nil && v.Width == 1920 && v.Duration == 12 && v.MimeType == "video/mp4" && v.Thumbnail != nil && v.Thumbnail.Width == 320
+			}},
+		{"voice", `{"voice": {"file_id": "vo", "duration": 4, "mime_type": "audio/ogg", "file_size": 9000}}`,
+			func(m Message) bool {
+				v := m.Voice
+				return v != nil && v.Duration == 4 && v.MimeType == "audio/ogg" && v.FileSize == 9000
+			}},
+		{"video note", `{"video_note": {"file_id": "vn", "length": 240, "duration": 9, ` + thumb + `}}`,
+			func(m Message) bool {
+				v := m.VideoNote
+				return v != nil && v.Length == 240 && v.Duration == 9 && v.Thumbnail != nil
+			}},
+		{"animation", `{"animation": {"file_id": "an", "width": 480, "height": 270, "duration": 3, "file_name": "lucu.mp4", "mime_type": "video/mp4"}}`,
+			func(m Message) bool {
+				a := m.Animation
+				return a != nil && a.Width == 480 && a.FileName == "lucu.mp4" && a.Thumbnail == nil
+			}},
+		{"document", `{"document": {"file_id": "d", "file_unique_id": "du", "file_name": "laporan.pdf", "mime_type": "application/pdf", "file_size": 1024, ` + thumb + `}}`,
+			func(m Message) bool {
+				d := m.Document
+				return d.FileName == "laporan.pdf" && d.MimeType == "application/pdf" && d.FileSize == 1024 && d.Thumbnail != nil
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msg Message
+			if err := json.Unmarshal([]byte(tt.payload), &msg); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if !tt.check(msg) {
+				t.Errorf("decoded %s = %+v", tt.name, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package telegrambot
+
+// EffectiveMessage mengembalikan pesan dari update, apa pun jenisnya: message,
+// edited_message, channel_post, atau edited_channel_post. Nil jika update tidak membawa pesan.
+func (u Update) EffectiveMessage() *Message {
+	switch {
+	case u.Message.MessageID != 0:
+		return &u.Message
+	case u.EditedMessage != nil:
+		return u.EditedMessage
+	case u.ChannelPost != nil:
+		return u.ChannelPost
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// InputFile adalah file yang dikirim ke Telegram: file lokal, io.Reader,
+// URL remote, atau file_id yang sudah ada. Isi hanya salah satu field.
+type InputFile struct {
+	Path   string    // path file lokal, di-upload sebagai multipart
+	Reader io.Reader // isi file, di-upload sebagai multipart
+	Name   string    // nama file untuk Reader
+	URL    string    // URL remote yang diunduh sendiri oleh Telegram
+	FileID string    // file_id dari file yang sudah ada di server Telegram
+}
+
+// FilePath membuat InputFile dari path file lokal
+func FilePath(path string) InputFile {
+	return InputFile{Path: path}
+}
+
+// FileReader membuat InputFile dari io.Reader dengan nama file tertentu
+func FileReader(name string, r io.Reader) InputFile {
+	return InputFile{Name: name, Reader: r}
+}
+
+// FileURL membuat InputFile dari URL remote
+func FileURL(u string) InputFile {
+	return InputFile{URL: u}
+}
+
+// FileID membuat InputFile dari file_id yang sudah ada
+func FileID(id string) InputFile {
+	return InputFile{FileID: id}
+}
+
+// needsUpload melaporkan apakah file harus dikirim sebagai multipart
+func (f InputFile) needsUpload() bool {
+	return f.Path != "" || f.Reader != nil
+}
+
+// value mengembalikan nilai form untuk URL atau file_id
+func (f InputFile) value() string {
+	if f.FileID != "" {
+		return f.FileID
+	}
+	return f.URL
+}
+
+// fileName mengembalikan nama file untuk bagian multipart
+func (f InputFile) fileName(field string) string {
+	if f.Name != "" {
+		return f.Name
+	}
+	if f.Path != "" {
+		return filepath.Base(f.Path)
+	}
+	return field
+}
+
+// uploadFile memasangkan nama field form dengan file yang dikirim
+type uploadFile struct {
+	field string
+	file  InputFile
+}
+
+const (
+	// maxUploadSize adalah batas ukuran upload Bot API (50 MB)
+	maxUploadSize = 50 << 20
+	// maxLocalUploadSize adalah batas ukuran upload Local Bot API Server (2000 MB)
+	maxLocalUploadSize = 2000 << 20
+)
+
+// ErrFileTooLarge dikembalikan (terbungkus) jika file melebihi batas upload
+var ErrFileTooLarge = errors.New("telegram: file exceeds upload size limit")
+
+// uploadLimit mengembalikan batas ukuran upload dalam byte
+func (b *Bot) uploadLimit() int64 {
+	if b.LocalMode {
+		return maxLocalUploadSize
+	}
+	return maxUploadSize
+}
+
+// size mengembalikan ukuran file jika dapat diketahui tanpa membacanya
+func (f InputFile) size() (int64, bool) {
+	if f.Path != "" {
+		info, err := os.Stat(f.Path)
+		if err != nil {
+			return 0, false
+		}
+		return info.Size(), true
+	}
+	switch r := f.Reader.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case interface{ Stat() (os.FileInfo, error) }:
+		if info, err := r.Stat(); err == nil && info.Mode().IsRegular() {
+			return info.Size(), true
+		}
+	}
+	return 0, false
+}
+
+// doUpload memanggil method API dengan file. File URL/file_id dikirim sebagai
+// field form biasa; file lokal dan reader di-stream sebagai multipart/form-data
+// sehingga isi file tidak pernah dimuat seluruhnya ke memori.
+func (b *Bot) doUpload(ctx context.Context, method string, params url.Values, files []uploadFile, out interface{}) error {
+	limit := b.uploadLimit()
+	upload := false
+	for _, f := range files {
+		if f.file.needsUpload() {
+			if size, ok := f.file.size(); ok && size > limit {
+				return fmt.Errorf("%w: %s is %d bytes, limit is %d bytes", ErrFileTooLarge, f.file.fileName(f.field), size, limit)
+			}
+			upload = true
+			continue
+		}
+		if f.file.value() == "" {
+			return fmt.Errorf("telegram: %s: empty input file for %q", method, f.field)
+		}
+		params.Set(f.field, f.file.value())
+	}
+	if !upload {
+		return b.doRequest(ctx, method, params, out)
+	}
+
+	if err := b.waitRateLimit(ctx, method, params.Get("chat_id")); err != nil {
+		return err
+	}
+	ctx, cancel := b.callContext(ctx)
+	defer cancel()
+	return b.call(ctx, b.client(), method, multipartBody(params, files, limit), out)
+}
+
+// multipartBody membuat body multipart yang ditulis lewat io.Pipe saat request dikirim.
+// Body hanya dapat diulang jika semua file berasal dari path.
+func multipartBody(params url.Values, files []uploadFile, limit int64) requestBody {
+	proto := multipart.NewWriter(ioutil.Discard)
+	replayable := true
+	for _, f := range files {
+		if f.file.needsUpload() && f.file.Path == "" {
+			replayable = false
+		}
+	}
+
+	return requestBody{
+		contentType: proto.FormDataContentType(),
+		replayable:  replayable,
+		open: func() io.Reader {
+			pr, pw := io.Pipe()
+			go func() {
+				w := multipart.NewWriter(pw)
+				if err := w.SetBoundary(proto.Boundary()); err != nil {
+					pw.CloseWithError(err)
+					return
+				}
+				pw.CloseWithError(writeMultipart(w, params, files, limit))
+			}()
+			return pr
+		},
+	}
+}
+
+// writeMultipart menulis params dan file yang perlu di-upload lalu menutup writer
+func writeMultipart(w *multipart.Writer, params url.Values, files []uploadFile, limit int64) error {
+	for key, values := range params {
+		for _, v := range values {
+			if err := w.WriteField(key, v); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range files {
+		if !f.file.needsUpload() {
+			continue
+		}
+		if err := writeFilePart(w, f.field, f.file, limit); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// writeFilePart menulis satu file sebagai bagian multipart dengan nama dan content type yang sesuai
+func writeFilePart(w *multipart.Writer, field string, f InputFile, limit int64) error {
+	r := f.Reader
+	if r == nil {
+		file, err := os.Open(f.Path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	name := f.fileName(field)
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, escapeQuotes(field), escapeQuotes(name)))
+	h.Set("Content-Type", contentType)
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+
+	// baca satu byte lebih dari batas untuk mendeteksi reader yang ukurannya tidak diketahui
+	n, err := io.Copy(part, io.LimitReader(r, limit+1))
+	if err != nil {
+		return err
+	}
+	if n > limit {
+		return fmt.Errorf("%w: %s is larger than %d bytes", ErrFileTooLarge, name, limit)
+	}
+	return nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes meng-escape nama field/file untuk header Content-Disposition
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
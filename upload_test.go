@@ -0,0 +1,160 @@
+package telegrambot
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// serverTransport mengarahkan semua request ke server test
+type serverTransport struct{ target *url.URL }
+
+func (t serverTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme, r.URL.Host = t.target.Scheme, t.target.Host
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+// newServerBot membuat Bot yang request-nya diarahkan ke srv
+func newServerBot(srv *httptest.Server) *Bot {
+	target, _ := url.Parse(srv.URL)
+	return NewBotWithClient("123:abc", &http.Client{Transport: serverTransport{target}})
+}
+
+// patternReader menghasilkan size byte tanpa menyimpannya di memori dan menghitung pembacaan
+type patternReader struct {
+	size     int64
+	produced int64 // diakses atomik karena dibaca dari handler server
+	reads    int64
+}
+
+func (r *patternReader) Read(p []byte) (int, error) {
+	remaining := r.size - atomic.LoadInt64(&r.produced)
+	if remaining <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	for i := range p {
+		p[i] = 'x'
+	}
+	atomic.AddInt64(&r.produced, int64(len(p)))
+	atomic.AddInt64(&r.reads, 1)
+	return len(p), nil
+}
+
+func TestUploadStreamsReader(t *testing.T) {
+	const size = 16 << 20
+	src := &patternReader{size: size}
+
+	var producedAtStart int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mr, err := r.MultipartReader()
+		if err != nil {
+			t.Errorf("MultipartReader: %v", err)
+			return
+		}
+		var received int64
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("NextPart: %v", err)
+				return
+			}
+			if part.FormName() != "document" {
+				continue
+			}
+			buf := make([]byte, 32<<10)
+			n, _ := io.ReadFull(part, buf)
+			producedAtStart = atomic.LoadInt64(&src.produced)
+			rest, _ := io.Copy(ioutil.Discard, part)
+			received = int64(n) + rest
+		}
+		if received != size {
+			t.Errorf("server received %d bytes, want %d", received, size)
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	}))
+	defer srv.Close()
+
+	bot := newServerBot(srv)
+	msg, err := bot.SendDocument(1, FileReader("big.bin", src), "")
+	if err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+	if msg.MessageID != 1 {
+		t.Fatalf("MessageID = %d, want 1", msg.MessageID)
+	}
+	if producedAtStart >= size {
+		t.Errorf("reader fully consumed (%d bytes) before the server read the first chunk; body was buffered", producedAtStart)
+	}
+	if reads := atomic.LoadInt64(&src.reads); reads < 2 {
+		t.Errorf("reader read %d times, want many small reads", reads)
+	}
+}
+
+func TestUploadUnknownSizeTooLarge(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(ioutil.Discard, r.Body)
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	}))
+	defer srv.Close()
+
+	bot := newServerBot(srv)
+	// patternReader tidak punya Len/Stat, jadi ukurannya baru diketahui saat di-stream
+	src := &patternReader{size: maxUploadSize + 1}
+	_, err := bot.SendDocument(1, FileReader("big.bin", src), "")
+	if !errors.Is(err, ErrFileTooLarge) {
+		t.Fatalf("err = %v, want ErrFileTooLarge", err)
+	}
+}
+
+// failingTransport gagal tanpa membaca atau menutup body request
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("dial failed")
+}
+
+func TestUploadClosesPipeOnEarlyError(t *testing.T) {
+	tests := []struct {
+		name string
+		bot  func() *Bot
+	}{
+		{"invalid url", func() *Bot {
+			b := NewBot("123:abc")
+			b.BaseURL = "http://bad host"
+			return b
+		}},
+		{"transport error", func() *Bot {
+			return NewBotWithClient("123:abc", &http.Client{Transport: failingTransport{}})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := runtime.NumGoroutine()
+			src := &patternReader{size: 1 << 20}
+			if _, err := tt.bot().SendDocument(1, FileReader("a.bin", src), ""); err == nil {
+				t.Fatal("SendDocument succeeded, want error")
+			}
+			deadline := time.Now().Add(2 * time.Second)
+			for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+				time.Sleep(5 * time.Millisecond)
+			}
+			if n := runtime.NumGoroutine(); n > before {
+				t.Errorf("goroutines = %d, want %d; multipart writer still blocked", n, before)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package telegrambot
+
+import "context"
+
+// answerWebAppQueryRequest adalah body JSON answerWebAppQuery
+type answerWebAppQueryRequest struct {
+	WebAppQueryID string            `json:"web_app_query_id"`
+	Result        InlineQueryResult `json:"result"`
+}
+
+// AnswerWebAppQuery menjawab query dari Web App (web_app_query_id dari initData) dengan
+// mengirim satu hasil inline atas nama pengguna
+func (b *Bot) AnswerWebAppQuery(queryID string, result InlineQueryResult) (*SentWebAppMessage, error) {
+	req := answerWebAppQueryRequest{WebAppQueryID: queryID, Result: result}
+
+	var sent SentWebAppMessage
+	if err := b.doJSONRequest(context.Background(), "answerWebAppQuery", req, &sent); err != nil {
+		return nil, err
+	}
+	return &sent, nil
+}
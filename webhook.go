@@ -0,0 +1,89 @@
+package telegrambot
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// secretTokenHeader adalah header tempat Telegram mengirim secret_token webhook
+const secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// maxWebhookBodySize membatasi ukuran body webhook; satu Update jauh lebih kecil dari ini
+const maxWebhookBodySize = 4 << 20
+
+// WebhookConfig berisi parameter opsional setWebhook. Field yang bernilai nol tidak dikirim.
+type WebhookConfig struct {
+	MaxConnections     int      `json:"max_connections,omitempty"` // 1-100
+	AllowedUpdates     []string `json:"allowed_updates,omitempty"`
+	IPAddress          string   `json:"ip_address,omitempty"`
+	SecretToken        string   `json:"secret_token,omitempty"` // dikirim ulang di header X-Telegram-Bot-Api-Secret-Token
+	DropPendingUpdates bool     `json:"drop_pending_updates,omitempty"`
+}
+
+// setWebhookRequest adalah body JSON setWebhook
+type setWebhookRequest struct {
+	URL string `json:"url"`
+	WebhookConfig
+}
+
+// SetWebhook mendaftarkan URL webhook sehingga update dikirim lewat HTTPS, bukan polling
+func (b *Bot) SetWebhook(webhookURL string, opts WebhookConfig) error {
+	req := setWebhookRequest{URL: webhookURL, WebhookConfig: opts}
+	return b.doJSONRequest(context.Background(), "setWebhook", req, nil)
+}
+
+// DeleteWebhook menghapus webhook; dropPending membuang update yang belum terkirim
+func (b *Bot) DeleteWebhook(dropPending bool) error {
+	return b.deleteWebhook(context.Background(), dropPending)
+}
+
+// deleteWebhook memanggil deleteWebhook dengan ctx
+func (b *Bot) deleteWebhook(ctx context.Context, dropPending bool) error {
+	data := url.Values{}
+	if dropPending {
+		data.Set("drop_pending_updates", "true")
+	}
+	return b.doRequest(ctx, "deleteWebhook", data, nil)
+}
+
+// GetWebhookInfo mengambil status webhook saat ini
+func (b *Bot) GetWebhookInfo() (*WebhookInfo, error) {
+	var info WebhookInfo
+	if err := b.doRequest(context.Background(), "getWebhookInfo", url.Values{}, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// WebhookHandler membuat http.Handler yang men-decode body request menjadi Update lalu
+// memanggil handler. Jika WebhookSecret diisi, request dengan header secret yang tidak
+// cocok ditolak dengan 403; JSON yang rusak atau body lebih dari 4 MB dibalas 400.
+func (b *Bot) WebhookHandler(handler func(Update)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if b.WebhookSecret != "" {
+			got := r.Header.Get(secretTokenHeader)
+			if subtle.ConstantTimeCompare([]byte(got), []byte(b.WebhookSecret)) != 1 {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+		}
+
+		var update Update
+		body := http.MaxBytesReader(w, r.Body, maxWebhookBodySize)
+		if err := json.NewDecoder(body).Decode(&update); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		handler(update)
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,35 @@
+package telegrambot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhookHandlerBodyLimit(t *testing.T) {
+	bot := NewBot("123:abc")
+	var got []Update
+	h := bot.WebhookHandler(func(u Update) { got = append(got, u) })
+
+	tests := []struct {
+		name string
+		body string
+		code int
+	}{
+		{"update", `{"update_id":7}`, http.StatusOK},
+		{"too large", `{"update_id":8,"pad":"` + strings.Repeat("x", maxWebhookBodySize) + `"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(tt.body)))
+			if rec.Code != tt.code {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.code)
+			}
+		})
+	}
+	if len(got) != 1 || got[0].UpdateID != 7 {
+		t.Fatalf("handled updates = %+v, want only update 7", got)
+	}
+}
@@ -0,0 +1,125 @@
+package telegrambot
+
+import "sync"
+
+// workerQueueSize adalah kapasitas antrean setiap worker
+const workerQueueSize = 100
+
+// ProcessOption mengatur cara ProcessUpdates dan Dispatcher.Run menjalankan handler
+type ProcessOption func(*processConfig)
+
+// processConfig berisi pengaturan dari ProcessOption
+type processConfig struct {
+	workers int
+	perChat bool
+}
+
+// newProcessConfig menerapkan opts di atas default: satu worker dan urutan per chat dijaga
+func newProcessConfig(opts []ProcessOption) processConfig {
+	cfg := processConfig{workers: 1, perChat: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithWorkers menjalankan handler di n goroutine sehingga update dari chat berbeda diproses
+// paralel. n <= 1 berarti update diproses satu per satu (default).
+func WithWorkers(n int) ProcessOption {
+	return func(c *processConfig) {
+		c.workers = n
+	}
+}
+
+// WithPerChatOrdering menentukan apakah update dari chat yang sama selalu diproses berurutan
+// oleh worker yang sama (default true). Jika false, update dibagi ke worker mana pun yang
+// kosong sehingga dua update dari satu chat dapat diproses bersamaan.
+func WithPerChatOrdering(enabled bool) ProcessOption {
+	return func(c *processConfig) {
+		c.perChat = enabled
+	}
+}
+
+// workerPool membagi update ke beberapa goroutine. Dengan urutan per chat, setiap worker
+// punya antrean sendiri dan chat di-hash ke salah satunya; tanpa itu semua worker berbagi
+// satu antrean.
+type workerPool struct {
+	queues []chan Update
+	wg     sync.WaitGroup
+}
+
+// newWorkerPool menjalankan cfg.workers goroutine yang memanggil handle untuk setiap update
+func newWorkerPool(cfg processConfig, handle func(Update)) *workerPool {
+	n := cfg.workers
+	queues := n
+	if !cfg.perChat {
+		queues = 1
+	}
+
+	p := &workerPool{queues: make([]chan Update, queues)}
+	for i := range p.queues {
+		p.queues[i] = make(chan Update, workerQueueSize)
+	}
+	for i := 0; i < n; i++ {
+		queue := p.queues[i%queues]
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for u := range queue {
+				handle(u)
+			}
+		}()
+	}
+	return p
+}
+
+// submit memasukkan update ke antrean worker; memblokir jika antrean tersebut penuh
+func (p *workerPool) submit(u Update) {
+	i := 0
+	if len(p.queues) > 1 {
+		key, ok := orderingKey(u)
+		if !ok {
+			key = int64(u.UpdateID)
+		}
+		i = int(uint64(key) % uint64(len(p.queues)))
+	}
+	p.queues[i] <- u
+}
+
+// close menutup antrean lalu menunggu semua update yang sudah masuk selesai diproses
+func (p *workerPool) close() {
+	for _, q := range p.queues {
+		close(q)
+	}
+	p.wg.Wait()
+}
+
+// orderingKey mengembalikan id chat (atau user jika update tidak terikat chat) yang
+// urutannya harus dijaga
+func orderingKey(u Update) (int64, bool) {
+	if msg := u.EffectiveMessage(); msg != nil {
+		return msg.Chat.ID, true
+	}
+	switch {
+	case u.CallbackQuery != nil:
+		if u.CallbackQuery.Message != nil {
+			return u.CallbackQuery.Message.Chat.ID, true
+		}
+		return int64(u.CallbackQuery.From.ID), true
+	case u.MyChatMember != nil:
+		return u.MyChatMember.Chat.ID, true
+	case u.ChatMember != nil:
+		return u.ChatMember.Chat.ID, true
+	case u.MessageReaction != nil:
+		return u.MessageReaction.Chat.ID, true
+	case u.ChatJoinRequest != nil:
+		return u.ChatJoinRequest.Chat.ID, true
+	case u.InlineQuery != nil:
+		return int64(u.InlineQuery.From.ID), true
+	case u.ShippingQuery != nil:
+		return int64(u.ShippingQuery.From.ID), true
+	case u.PreCheckoutQuery != nil:
+		return int64(u.PreCheckoutQuery.From.ID), true
+	}
+	return 0, false
+}
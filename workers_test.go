@@ -0,0 +1,85 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestProcessUpdatesPerChatOrdering(t *testing.T) {
+	const chats, perChat = 4, 40
+
+	// update dari semua chat diselang-seling dalam satu batch
+	var batch []Update
+	for i := 0; i < perChat; i++ {
+		for c := 1; c <= chats; c++ {
+			u := Update{UpdateID: len(batch) + 1}
+			u.Message = Message{MessageID: i + 1, Chat: Chat{ID: int64(-c)}}
+			batch = append(batch, u)
+		}
+	}
+	encoded, _ := json.Marshal(batch)
+
+	var served int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&served, 1) == 1 {
+			w.Write([]byte(`{"ok":true,"result":` + string(encoded) + `}`))
+			return
+		}
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		w.Write([]byte(`{"ok":true,"result":[]}`))
+	}))
+	defer srv.Close()
+
+	bot := NewBot("123:abc")
+	bot.BaseURL = srv.URL
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var mu sync.Mutex
+	seen := make(map[int64][]int)
+	var running, maxRunning, handled int32
+	handler := func(u Update) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		seen[u.Message.Chat.ID] = append(seen[u.Message.Chat.ID], u.Message.MessageID)
+		mu.Unlock()
+		atomic.AddInt32(&running, -1)
+		if atomic.AddInt32(&handled, 1) == chats*perChat {
+			cancel()
+		}
+	}
+
+	err := bot.ProcessUpdates(ctx, 1, handler, WithWorkers(chats), WithPerChatOrdering(true))
+	if err != context.Canceled {
+		t.Fatalf("ProcessUpdates = %v, want context.Canceled", err)
+	}
+	if handled != chats*perChat {
+		t.Fatalf("handled %d updates, want %d", handled, chats*perChat)
+	}
+	for chat, ids := range seen {
+		for i, id := range ids {
+			if id != i+1 {
+				t.Fatalf("chat %d processed out of order: %v", chat, ids)
+			}
+		}
+	}
+	if maxRunning < 2 {
+		t.Errorf("max concurrent handlers = %d, want updates from different chats in parallel", maxRunning)
+	}
+}